@@ -43,12 +43,19 @@ resource "ciscosecureaccess_destination_list" "content_dl" {
   ]
 }
 
+# 16 to 64 letters and digits with upper case, lower case and digits. Pass it
+# in with TF_VAR_tunnel_preshared_key rather than committing it.
+variable "tunnel_preshared_key" {
+  type      = string
+  sensitive = true
+}
+
 resource "ciscosecureaccess_network_tunnel_group" "test_tunnel1" {
   name              = "TF Test Tunnel 1"
   network_cidrs     = ["10.17.177.0/24"]
   region            = "us-test-2"
   identifier_prefix = "tftesttunnel1"
-  preshared_key     = "Testing1Testing1"
+  preshared_key     = var.tunnel_preshared_key
   device_type       = "other"
 }
 
@@ -57,7 +64,7 @@ resource "ciscosecureaccess_network_tunnel_group" "test_tunnel2" {
   network_cidrs     = ["10.17.178.0/24"]
   region            = "us-test-2"
   identifier_prefix = "tftesttunnel2"
-  preshared_key     = "Testing1Testing1"
+  preshared_key     = var.tunnel_preshared_key
   device_type       = "other"
 }
 
@@ -0,0 +1,10 @@
+# Audit enabled internet rules whose names start with "saas-"
+data "ciscosecureaccess_access_policies" "saas" {
+  name_regex   = "^saas-"
+  traffic_type = "PUBLIC_INTERNET"
+  enabled      = true
+}
+
+output "saas_rule_ids" {
+  value = [for p in data.ciscosecureaccess_access_policies.saas.access_policies : p.id]
+}
@@ -0,0 +1,9 @@
+# List the child organizations of a Managed Service Provider organization.
+# The provider must use the parent organization API key without child_org_id.
+data "ciscosecureaccess_child_orgs" "customers" {
+  name_regex = "^Acme"
+}
+
+output "customer_org_ids" {
+  value = { for org in data.ciscosecureaccess_child_orgs.customers.child_orgs : org.name => org.id }
+}
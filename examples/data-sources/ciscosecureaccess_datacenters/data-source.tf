@@ -0,0 +1,9 @@
+# List the datacenter addresses to allow through the branch firewall.
+data "ciscosecureaccess_datacenters" "branch" {
+  region     = ciscosecureaccess_network_tunnel_group.branch.region
+  depends_on = [ciscosecureaccess_network_tunnel_group.branch]
+}
+
+output "datacenter_ips" {
+  value = { for datacenter in data.ciscosecureaccess_datacenters.branch.datacenters : datacenter.name => datacenter.ip }
+}
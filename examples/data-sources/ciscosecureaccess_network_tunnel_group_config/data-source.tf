@@ -0,0 +1,28 @@
+variable "tunnel_preshared_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "ciscosecureaccess_network_tunnel_group" "branch" {
+  name              = "Branch 1"
+  routing           = { type = "static", network_cidrs = ["10.10.110.0/24"] }
+  region            = "us-test-2"
+  identifier_prefix = "branch1"
+  preshared_key     = var.tunnel_preshared_key
+  device_type       = "ISR"
+}
+
+# IOS XE configuration for the ISR of the branch, with tunnels sourced from
+# its WAN interface and the networks reached through Secure Access routed
+# over them
+data "ciscosecureaccess_network_tunnel_group_config" "branch" {
+  id            = ciscosecureaccess_network_tunnel_group.branch.id
+  preshared_key = var.tunnel_preshared_key
+  tunnel_source = "GigabitEthernet0/0/0"
+  tunnel_routes = ["10.200.0.0/16"]
+}
+
+resource "local_sensitive_file" "branch_router_config" {
+  filename = "${path.module}/branch1-sse.cfg"
+  content  = data.ciscosecureaccess_network_tunnel_group_config.branch.config
+}
@@ -0,0 +1,21 @@
+# Check after a branch rollout that the branch device established its tunnels.
+data "ciscosecureaccess_network_tunnel_group_state" "branch" {
+  id = ciscosecureaccess_network_tunnel_group.branch.id
+
+  lifecycle {
+    postcondition {
+      condition = anytrue([
+        for hub in self.hubs : anytrue([for tunnel in hub.tunnels : tunnel.status == "UP"])
+      ])
+      error_message = "No tunnel of ${self.name} is up."
+    }
+  }
+}
+
+output "branch_tunnels" {
+  value = flatten([
+    for hub in data.ciscosecureaccess_network_tunnel_group_state.branch.hubs : [
+      for tunnel in hub.tunnels : "${hub.datacenter} ${tunnel.peer_ip}: ${tunnel.status} (IKE ${tunnel.ike_state}, IPsec ${tunnel.ipsec_state})"
+    ]
+  ])
+}
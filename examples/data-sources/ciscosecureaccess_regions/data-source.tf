@@ -0,0 +1,12 @@
+# Deploy a branch tunnel group to the region nearest to the branch device.
+data "ciscosecureaccess_regions" "branch" {
+  peer_ip = "198.51.100.10"
+}
+
+output "nearest_region" {
+  value = data.ciscosecureaccess_regions.branch.regions[0].id
+}
+
+output "secure_access_bgp_peers" {
+  value = data.ciscosecureaccess_regions.branch.bgp.peer_ips
+}
@@ -0,0 +1,20 @@
+# Generate a compliant preshared key without writing it to plan or state, and
+# hand it straight to the write-only preshared_key of a tunnel group. A new key
+# is generated on every run, but it is only written when the tunnel group is
+# created or preshared_key_version changes.
+ephemeral "ciscosecureaccess_network_tunnel_group_preshared_key" "branch" {
+  length = 32
+}
+
+resource "ciscosecureaccess_network_tunnel_group" "branch" {
+  name              = "Branch"
+  region            = "us-test-2"
+  identifier_prefix = "branch"
+  device_type       = "other"
+  routing = {
+    type          = "static"
+    network_cidrs = ["10.10.110.0/24"]
+  }
+  preshared_key         = ephemeral.ciscosecureaccess_network_tunnel_group_preshared_key.branch.result
+  preshared_key_version = 1
+}
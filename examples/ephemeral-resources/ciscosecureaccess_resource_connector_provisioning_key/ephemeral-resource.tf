@@ -0,0 +1,21 @@
+data "ciscosecureaccess_resource_connector" "aws" {
+  filter = {
+    name  = "name"
+    query = "aws-us-west-2"
+  }
+}
+
+# Fetch the provisioning key of the group without writing it to plan or state
+ephemeral "ciscosecureaccess_resource_connector_provisioning_key" "aws" {
+  group_id = data.ciscosecureaccess_resource_connector.aws.resource_connector_groups[0].id
+}
+
+# Hand the key to connector instances through a write-only argument, and have
+# cloud-init read it from the parameter at boot rather than embedding it in
+# user data, which is stored in state
+resource "aws_ssm_parameter" "provisioning_key" {
+  name             = "/secure-access/aws-us-west-2/provisioning-key"
+  type             = "SecureString"
+  value_wo         = ephemeral.ciscosecureaccess_resource_connector_provisioning_key.aws.provisioning_key
+  value_wo_version = 1
+}
@@ -8,6 +8,31 @@ resource "ciscosecureaccess_access_policy" "remote_to_pa" {
     source_ids = [for s in data.ciscosecureaccess_identity.remote_identity.identities : s.label]
     private_resource_ids = [resource.ciscosecureaccess_private_resource.new_resource.id]
     description = "Test rule for terraform access policy support"
+    reauthentication_minutes = 480
+}
+
+# Allow remote users to reach every private resource in a group. Application
+# owners change the group membership without touching this rule.
+resource "ciscosecureaccess_access_policy" "remote_to_finance_apps" {
+    name = "remote-user-finance-apps"
+    action = "allow"
+    enabled = "true"
+    traffic_type = "PRIVATE_NETWORK"
+    source_ids = [for s in data.ciscosecureaccess_identity.remote_identity.identities : s.label]
+    private_resource_group_ids = [ciscosecureaccess_private_resource_group.finance_apps.id]
+}
+
+# Allow directory users to reach selected applications on the internet
+resource "ciscosecureaccess_access_policy" "users_to_saas" {
+    name = "users-saas-apps"
+    action = "allow"
+    enabled = "true"
+    traffic_type = "PUBLIC_INTERNET"
+    source_types = ["directory_users"]
+    application_ids = [1008716, 5000041]
+    application_category_ids = [21]
+    security_profile_id = 1234
+    tenant_control_profile_id = 19383
 }
 
 data "ciscosecureaccess_identity" "remote_identity" {
@@ -18,3 +43,7 @@ resource "ciscosecureaccess_private_resource" "new_resource" {
 ...
 }
 
+resource "ciscosecureaccess_private_resource_group" "finance_apps" {
+...
+}
+
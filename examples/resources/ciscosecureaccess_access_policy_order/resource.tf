@@ -0,0 +1,9 @@
+# Evaluate the block rule before the allow rules. Leave priority unset on
+# access policies ordered here.
+resource "ciscosecureaccess_access_policy_order" "order" {
+  rule_ids = [
+    ciscosecureaccess_access_policy.block_guests.id,
+    ciscosecureaccess_access_policy.remote_to_pa.id,
+    ciscosecureaccess_access_policy.users_to_saas.id,
+  ]
+}
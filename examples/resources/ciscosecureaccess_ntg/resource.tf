@@ -1,8 +1,34 @@
+variable "tunnel_preshared_key" {
+    type = string
+    sensitive = true
+}
+
 resource "ciscosecureaccess_network_tunnel_group" "test_tunnel1" {
     name = "TF Test Tunnel 1"
-    network_cidrs = ["10.10.110.0/24"]
+    routing = {
+        type = "static"
+        network_cidrs = ["10.10.110.0/24"]
+    }
     region = "us-test-2"
     identifier_prefix = "remoteapptunnel"
-    preshared_key = "redactedredacted"
+    preshared_key = var.tunnel_preshared_key
+    # preshared_key is only written to an existing tunnel group when the
+    # version changes, so bump it together with the key to rotate it
+    preshared_key_version = 1
+    device_type = "other"
+}
+
+# Routes learned over BGP from the device. The API only accepts the AS number
+# of the device; multihop peering and advertised prefixes are configured on
+# the device itself.
+resource "ciscosecureaccess_network_tunnel_group" "test_tunnel2" {
+    name = "TF Test Tunnel 2"
+    routing = {
+        type = "bgp"
+        as_number = "65010"
+    }
+    region = "us-test-2"
+    identifier_prefix = "remotebgptunnel"
+    preshared_key = var.tunnel_preshared_key
     device_type = "other"
 }
@@ -0,0 +1,18 @@
+resource "ciscosecureaccess_private_resource_group" "finance_apps" {
+  name        = "Finance Apps"
+  description = "Private resources owned by the finance team"
+  resource_ids = [
+    ciscosecureaccess_private_resource.ledger.id,
+    ciscosecureaccess_private_resource.payroll.id,
+  ]
+}
+
+# Security owns the rule, the finance team owns the group membership
+resource "ciscosecureaccess_access_policy" "finance_apps" {
+  name                       = "finance-apps"
+  action                     = "allow"
+  enabled                    = true
+  traffic_type               = "PRIVATE_NETWORK"
+  source_types               = ["directory_users"]
+  private_resource_group_ids = [ciscosecureaccess_private_resource_group.finance_apps.id]
+}
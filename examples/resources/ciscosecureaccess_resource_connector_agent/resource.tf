@@ -2,4 +2,11 @@ resource "ciscosecureaccess_resource_connector_agent" "aws_rca" {
     instance_id = "i-0123456789abdef1" # Instance ID of resource connector in AWS
     confirmed = true
     enabled = true
+    # Only create the agent once the connector reports connected
+    wait_for_status = "connected"
+
+    # Wait longer for a freshly launched connector to register
+    timeouts {
+        create = "20m"
+    }
 }
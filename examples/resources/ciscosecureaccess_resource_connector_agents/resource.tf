@@ -0,0 +1,31 @@
+resource "ciscosecureaccess_resource_connector_group" "aws" {
+    name = "AWS Connectors"
+    location = "us-west-2"
+}
+
+# Confirm and enable every connector launched by an autoscaling group whose
+# instances are named rc-prod-*. Connectors that stop matching, such as
+# instances removed by a scale-in, are deleted from the group on the next apply.
+resource "ciscosecureaccess_resource_connector_agents" "autoscaling" {
+    group_id = ciscosecureaccess_resource_connector_group.aws.id
+    hostname_patterns = ["rc-prod-*"]
+    confirmed = true
+    enabled = true
+}
+
+# Manage a fixed set of connector instances and wait for them to connect
+resource "ciscosecureaccess_resource_connector_agents" "fixed" {
+    group_id = ciscosecureaccess_resource_connector_group.aws.id
+    instance_ids = ["i-0123456789abdef1", "i-0123456789abdef2"]
+    confirmed = true
+    enabled = true
+
+    wait_for = {
+        instance_ids = ["i-0123456789abdef1", "i-0123456789abdef2"]
+    }
+
+    # Wait longer for freshly launched connectors to register
+    timeouts {
+        create = "20m"
+    }
+}
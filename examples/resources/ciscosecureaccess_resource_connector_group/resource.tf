@@ -0,0 +1,43 @@
+resource "ciscosecureaccess_resource_connector_group" "aws_us_west_2" {
+    name = "AWS us-west-2"
+    location = "us-west-2"
+    environment = "aws"
+    # Bump to rotate the provisioning key
+    provisioning_key_version = 1
+}
+
+# Connectors deployed by another pipeline with the group's provisioning key.
+# Rotating the key blocks until both connectors report connected again.
+resource "ciscosecureaccess_resource_connector_group" "esx_datacenter" {
+    name = "ESX Datacenter"
+    location = "dc1"
+    environment = "esx"
+    provisioning_key_version = 1
+
+    wait_for = {
+        status = "connected"
+        instance_ids = ["rc-dc1-a", "rc-dc1-b"]
+    }
+
+    timeouts {
+        update = "30m"
+    }
+}
+
+# The connectors only register once the group exists: wait for them here, so
+# resources that depend on these connectors are only created once they are up
+resource "ciscosecureaccess_resource_connector_agents" "esx_datacenter" {
+    group_id = ciscosecureaccess_resource_connector_group.esx_datacenter.id
+    instance_ids = ["rc-dc1-a", "rc-dc1-b"]
+    confirmed = true
+    enabled = true
+
+    wait_for = {
+        status = "connected"
+        instance_ids = ["rc-dc1-a", "rc-dc1-b"]
+    }
+
+    timeouts {
+        create = "30m"
+    }
+}
@@ -0,0 +1,192 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/resconn"
+	"github.com/avast/retry-go/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultConnectorWaitStatus is the status connectors are waited for when
+	// wait_for does not set one
+	defaultConnectorWaitStatus = "connected"
+
+	// connectorWaitPollDelay is the initial delay between polls for connector
+	// readiness, which run until the operation timeout
+	connectorWaitPollDelay = 10 * time.Second
+)
+
+// connectorWaitModel maps the wait_for attribute, which blocks an apply until
+// connectors of a group report a status
+type connectorWaitModel struct {
+	Connectors  types.Int64  `tfsdk:"connectors"`
+	Status      types.String `tfsdk:"status"`
+	InstanceIDs types.Set    `tfsdk:"instance_ids"`
+}
+
+// connectorWaitAttribute returns the schema of the wait_for attribute,
+// described by description
+func connectorWaitAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"connectors": schema.Int64Attribute{
+				Description: "Number of connectors that must report status. Defaults to the number of instance_ids, or 1.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status the connectors must report. Defaults to " + defaultConnectorWaitStatus + ".",
+				Optional:    true,
+			},
+			"instance_ids": schema.SetAttribute{
+				Description: "Instance IDs of connectors that must be among the ready connectors, such as the IDs of the connector virtual machines.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// connectorReadiness describes which connectors of a group must report which
+// status
+type connectorReadiness struct {
+	GroupID     int64
+	Connectors  int
+	Status      string
+	InstanceIDs []string
+}
+
+// newConnectorReadiness resolves the defaults of a wait_for attribute
+func newConnectorReadiness(ctx context.Context, groupID int64, wait connectorWaitModel) connectorReadiness {
+	readiness := connectorReadiness{
+		GroupID: groupID,
+		Status:  defaultConnectorWaitStatus,
+	}
+	if !wait.Status.IsNull() && !wait.Status.IsUnknown() && wait.Status.ValueString() != "" {
+		readiness.Status = wait.Status.ValueString()
+	}
+	if !wait.InstanceIDs.IsNull() && !wait.InstanceIDs.IsUnknown() {
+		wait.InstanceIDs.ElementsAs(ctx, &readiness.InstanceIDs, false)
+		sort.Strings(readiness.InstanceIDs)
+	}
+	readiness.Connectors = max(len(readiness.InstanceIDs), 1)
+	if !wait.Connectors.IsNull() && !wait.Connectors.IsUnknown() {
+		readiness.Connectors = int(wait.Connectors.ValueInt64())
+	}
+	return readiness
+}
+
+// check returns nil when connectors satisfy the readiness requirements, and
+// otherwise an error naming the connectors that are missing or report another
+// status
+func (c connectorReadiness) check(connectors []resconn.ConnectorResponse) error {
+	statuses := make(map[string]string, len(connectors))
+	ready := 0
+	for _, connector := range connectors {
+		statuses[connector.GetInstanceId()] = connector.GetStatus()
+		if connector.GetStatus() == c.Status {
+			ready++
+		}
+	}
+
+	var missing, notReady []string
+	for _, instanceID := range c.InstanceIDs {
+		if status, ok := statuses[instanceID]; !ok {
+			missing = append(missing, instanceID)
+		} else if status != c.Status {
+			notReady = append(notReady, fmt.Sprintf("%s (%s)", instanceID, status))
+		}
+	}
+	if ready >= c.Connectors && len(missing) == 0 && len(notReady) == 0 {
+		return nil
+	}
+
+	// Without instance IDs to report on, name every connector that is not ready
+	if len(c.InstanceIDs) == 0 {
+		for instanceID, status := range statuses {
+			if status != c.Status {
+				notReady = append(notReady, fmt.Sprintf("%s (%s)", instanceID, status))
+			}
+		}
+		sort.Strings(notReady)
+	}
+
+	msg := fmt.Sprintf("%d of %d connectors in resource connector group %d report status %q", ready, c.Connectors, c.GroupID, c.Status)
+	if len(missing) > 0 {
+		msg += "; never appeared: " + strings.Join(missing, ", ")
+	}
+	if len(notReady) > 0 {
+		msg += "; other status: " + strings.Join(notReady, ", ")
+	}
+	return errors.New(msg)
+}
+
+// waitForConnectors polls the connectors of a group until they satisfy
+// readiness or ctx is done
+func waitForConnectors(ctx context.Context, apiClient resconn.APIClient, readiness connectorReadiness) error {
+	tflog.Info(ctx, "Waiting for resource connectors", map[string]interface{}{
+		"group_id":     readiness.GroupID,
+		"connectors":   readiness.Connectors,
+		"status":       readiness.Status,
+		"instance_ids": readiness.InstanceIDs,
+	})
+
+	return retry.Do(
+		func() error {
+			connectors, err := listGroupConnectors(ctx, apiClient, readiness.GroupID)
+			if err != nil {
+				return err
+			}
+			err = readiness.check(connectors)
+			if err != nil {
+				tflog.Debug(ctx, "Resource connectors not ready", map[string]interface{}{"reason": err.Error()})
+			}
+			return err
+		},
+		retryUntilDeadline(ctx, connectorWaitPollDelay)...,
+	)
+}
+
+// listGroupConnectors returns every connector of a group
+func listGroupConnectors(ctx context.Context, apiClient resconn.APIClient, groupID int64) ([]resconn.ConnectorResponse, error) {
+	filters, err := json.Marshal(map[string]string{"groupId": strconv.FormatInt(groupID, 10)})
+	if err != nil {
+		return nil, err
+	}
+
+	var connectors []resconn.ConnectorResponse
+	offset := int64(0)
+	for {
+		page, _, err := apiClient.ConnectorsAPI.ListConnectors(ctx).Filters(string(filters)).Offset(offset).Limit(importLookupPageLimit).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resource connectors: %w", err)
+		}
+		connectors = append(connectors, page.GetData()...)
+
+		offset += int64(len(page.GetData()))
+		if len(page.GetData()) == 0 || offset >= page.GetTotal() {
+			return connectors, nil
+		}
+	}
+}
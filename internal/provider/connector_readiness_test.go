@@ -0,0 +1,111 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/CiscoDevNet/go-ciscosecureaccess/resconn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+func TestNewConnectorReadiness_defaults(t *testing.T) {
+	ctx := context.Background()
+
+	readiness := newConnectorReadiness(ctx, 7, connectorWaitModel{
+		InstanceIDs: types.SetNull(types.StringType),
+	})
+	if readiness.Connectors != 1 || readiness.Status != defaultConnectorWaitStatus {
+		t.Errorf("unexpected defaults: %+v", readiness)
+	}
+
+	readiness = newConnectorReadiness(ctx, 7, connectorWaitModel{
+		InstanceIDs: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("i-2"), types.StringValue("i-1")}),
+		Status:      types.StringValue("reachable"),
+	})
+	if readiness.Connectors != 2 || readiness.Status != "reachable" || readiness.InstanceIDs[0] != "i-1" {
+		t.Errorf("unexpected readiness: %+v", readiness)
+	}
+}
+
+func TestConnectorReadinessCheck(t *testing.T) {
+	connector := func(instanceID, status string) resconn.ConnectorResponse {
+		c := resconn.NewConnectorResponse()
+		c.SetInstanceId(instanceID)
+		c.SetStatus(status)
+		return *c
+	}
+	connectors := []resconn.ConnectorResponse{
+		connector("i-1", "connected"),
+		connector("i-2", "disconnected"),
+	}
+
+	tests := []struct {
+		name      string
+		readiness connectorReadiness
+		wantErr   []string
+	}{
+		{"count reached", connectorReadiness{Connectors: 1, Status: "connected"}, nil},
+		{"count not reached", connectorReadiness{Connectors: 2, Status: "connected"}, []string{"1 of 2", "other status: i-2 (disconnected)"}},
+		{"instance ready", connectorReadiness{Connectors: 1, Status: "connected", InstanceIDs: []string{"i-1"}}, nil},
+		{"instance missing", connectorReadiness{Connectors: 1, Status: "connected", InstanceIDs: []string{"i-1", "i-3"}}, []string{"never appeared: i-3"}},
+		{"instance not ready", connectorReadiness{Connectors: 1, Status: "connected", InstanceIDs: []string{"i-2"}}, []string{"other status: i-2 (disconnected)"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.readiness.check(connectors)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("expected ready, got: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWaitForConnectors(t *testing.T) {
+	fake := newFakeAPIServer()
+	defer fake.Close()
+
+	factory := &client.SSEClientFactory{
+		KeyId:         fakeAPIKeyID,
+		KeySecret:     fakeAPIKeySecret,
+		ApiEndpoint:   fake.Endpoint(),
+		SSEHttpClient: fake.HTTPClient(),
+	}
+	apiClient := *factory.GetResConnClient(context.Background())
+	groupID := fake.SeedConnectorGroup("tf-test-wait")
+	fake.SeedGroupConnector(groupID, "i-ready", "connected")
+	fake.SeedConnectorAgent("tf-test-other-group")
+
+	ready := connectorReadiness{GroupID: groupID, Connectors: 1, Status: "connected", InstanceIDs: []string{"i-ready"}}
+	if err := waitForConnectors(context.Background(), apiClient, ready); err != nil {
+		t.Fatalf("waitForConnectors: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	missing := connectorReadiness{GroupID: groupID, Connectors: 2, Status: "connected", InstanceIDs: []string{"i-ready", "i-missing"}}
+	err := waitForConnectors(ctx, apiClient, missing)
+	if err == nil || !strings.Contains(err.Error(), "never appeared: i-missing") {
+		t.Errorf("expected the missing instance to be reported, got: %v", err)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const (
+	// defaultProfile is the credentials file profile used when no profile is
+	// configured
+	defaultProfile = "default"
+
+	// credentialProcessTimeout bounds how long credential_process may run
+	credentialProcessTimeout = time.Minute
+)
+
+// credentialSource supplies an API key ID, secret or both. Sources return
+// empty strings for values they do not provide.
+type credentialSource struct {
+	// attribute is the provider attribute diagnostics are reported against
+	attribute string
+	resolve   func(ctx context.Context) (keyID, keySecret string, err error)
+}
+
+// credentialSources lists where the API key ID and secret are looked up, in
+// order of precedence: the key_id, key_secret and key_secret_file
+// attributes, credential_process, an explicitly selected profile, the
+// environment, and finally the default profile of the credentials file when
+// that file exists.
+func credentialSources(config ciscosecureaccessProviderModel) []credentialSource {
+	credentialsFile := resolveStringSetting(config.CredentialsFile, envCredentialsFile)
+	profile := resolveStringSetting(config.Profile, envProfile)
+
+	sources := []credentialSource{
+		{"key_secret_file", func(context.Context) (string, string, error) {
+			secret, err := readSecretFile(config.KeySecretFile.ValueString())
+			return config.KeyID.ValueString(), firstNonEmpty(config.KeySecret.ValueString(), secret), err
+		}},
+	}
+	if process := resolveStringSetting(config.CredentialProcess, envCredentialProcess); process != "" {
+		sources = append(sources, credentialSource{"credential_process", func(ctx context.Context) (string, string, error) {
+			return runCredentialProcess(ctx, process)
+		}})
+	}
+	if profile != "" {
+		sources = append(sources, credentialSource{"profile", func(ctx context.Context) (string, string, error) {
+			return readProfile(ctx, credentialsFile, profile, true)
+		}})
+	}
+	sources = append(sources,
+		credentialSource{"key_secret_file", func(context.Context) (string, string, error) {
+			secret, err := readSecretFile(os.Getenv(envKeySecretFile))
+			return os.Getenv(envKeyID), firstNonEmpty(os.Getenv(envKeySecret), secret), err
+		}},
+		credentialSource{"credentials_file", func(ctx context.Context) (string, string, error) {
+			return readProfile(ctx, credentialsFile, defaultProfile, false)
+		}},
+	)
+	return sources
+}
+
+// resolveCredentials looks the API key ID and secret up in every credential
+// source, each value coming from the first source that provides it
+func resolveCredentials(ctx context.Context, config ciscosecureaccessProviderModel) (keyID, keySecret string, diags diag.Diagnostics) {
+	for _, source := range credentialSources(config) {
+		if keyID != "" && keySecret != "" {
+			break
+		}
+		id, secret, err := source.resolve(ctx)
+		if err != nil {
+			diags.AddAttributeError(path.Root(source.attribute), "Invalid Cisco Secure Access Credentials", err.Error())
+			return "", "", diags
+		}
+		keyID = firstNonEmpty(keyID, id)
+		keySecret = firstNonEmpty(keySecret, secret)
+	}
+	return keyID, keySecret, diags
+}
+
+// readSecretFile returns the content of name without surrounding whitespace,
+// or an empty string when name is empty
+func readSecretFile(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the API key secret file: %w", err)
+	}
+	secret := strings.TrimSpace(string(data))
+	if secret == "" {
+		return "", fmt.Errorf("the API key secret file %s is empty", name)
+	}
+	return secret, nil
+}
+
+// credentialProcessOutput is the JSON document credential_process must print
+type credentialProcessOutput struct {
+	KeyID     string `json:"key_id"`
+	KeySecret string `json:"key_secret"`
+}
+
+// runCredentialProcess runs command through the system shell and parses the
+// credentials it prints. Only stderr is included in errors, since stdout
+// holds the secret.
+func runCredentialProcess(ctx context.Context, command string) (string, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialProcessTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return "", "", fmt.Errorf("credential_process failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var output credentialProcessOutput
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return "", "", fmt.Errorf(`credential_process must print a JSON object such as {"key_id": "...", "key_secret": "..."}: %w`, err)
+	}
+	if output.KeyID == "" || output.KeySecret == "" {
+		return "", "", errors.New("credential_process output must contain both key_id and key_secret")
+	}
+	return output.KeyID, output.KeySecret, nil
+}
+
+// defaultCredentialsFile returns ~/.ciscosecureaccess/credentials
+func defaultCredentialsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".ciscosecureaccess", "credentials")
+}
+
+// readProfile reads the key_id and key_secret of profile from an INI style
+// credentials file:
+//
+//	[customer-a]
+//	key_id     = ...
+//	key_secret = ...
+//
+// A profile may set key_secret_file or credential_process instead of
+// key_secret. When required is false, a missing file or profile is not an
+// error.
+func readProfile(ctx context.Context, name, profile string, required bool) (string, string, error) {
+	if name == "" {
+		name = defaultCredentialsFile()
+	}
+	values, err := parseProfile(name, profile)
+	if errors.Is(err, os.ErrNotExist) && !required {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	keyID, keySecret := values["key_id"], values["key_secret"]
+	if keySecret == "" {
+		if keySecret, err = readSecretFile(values["key_secret_file"]); err != nil {
+			return "", "", fmt.Errorf("profile %q: %w", profile, err)
+		}
+	}
+	if process := values["credential_process"]; process != "" && (keyID == "" || keySecret == "") {
+		id, secret, err := runCredentialProcess(ctx, process)
+		if err != nil {
+			return "", "", fmt.Errorf("profile %q: %w", profile, err)
+		}
+		keyID, keySecret = firstNonEmpty(keyID, id), firstNonEmpty(keySecret, secret)
+	}
+	return keyID, keySecret, nil
+}
+
+// parseProfile returns the key/value pairs of one section of an INI file. It
+// returns an error wrapping os.ErrNotExist when the file or section is
+// missing.
+func parseProfile(name, profile string) (map[string]string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the credentials file: %w", err)
+	}
+	defer file.Close()
+
+	var values map[string]string
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		switch {
+		case text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";"):
+		case strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]"):
+			if values != nil {
+				return values, nil
+			}
+			if strings.TrimSpace(text[1:len(text)-1]) == profile {
+				values = map[string]string{}
+			}
+		default:
+			key, value, ok := strings.Cut(text, "=")
+			if !ok {
+				return nil, fmt.Errorf("%s:%d: expected key = value", name, line)
+			}
+			if values != nil {
+				values[strings.TrimSpace(key)] = strings.TrimSpace(value)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the credentials file: %w", err)
+	}
+	if values == nil {
+		return nil, fmt.Errorf("profile %q not found in %s: %w", profile, name, os.ErrNotExist)
+	}
+	return values, nil
+}
+
+// firstNonEmpty returns the first non-empty string of values
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,184 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testCredentialsFile = `# Shared Secure Access credentials
+[default]
+key_id     = default-id
+key_secret = default-secret
+
+[customer-a]
+key_id          = customer-a-id
+key_secret_file = %s
+
+[customer-b]
+key_id = customer-b-id
+`
+
+// testCredentialsModel returns a provider model with every credential
+// attribute null
+func testCredentialsModel() ciscosecureaccessProviderModel {
+	return ciscosecureaccessProviderModel{
+		KeyID:             types.StringNull(),
+		KeySecret:         types.StringNull(),
+		KeySecretFile:     types.StringNull(),
+		Profile:           types.StringNull(),
+		CredentialsFile:   types.StringNull(),
+		CredentialProcess: types.StringNull(),
+	}
+}
+
+// setupCredentials clears the credential environment variables and writes a
+// credentials file and secret file, returning the credentials file path
+func setupCredentials(t *testing.T) string {
+	t.Helper()
+	for _, envVar := range []string{envKeyID, envKeySecret, envKeySecretFile, envProfile, envCredentialsFile, envCredentialProcess} {
+		t.Setenv(envVar, "")
+	}
+	dir := t.TempDir()
+	// Keep the default profile of the real home directory out of the tests
+	t.Setenv("HOME", dir)
+	t.Setenv("USERPROFILE", dir)
+
+	secretFile := filepath.Join(dir, "secret")
+	if err := os.WriteFile(secretFile, []byte("customer-a-secret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	credentialsFile := filepath.Join(dir, "credentials")
+	content := strings.Replace(testCredentialsFile, "%s", secretFile, 1)
+	if err := os.WriteFile(credentialsFile, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return credentialsFile
+}
+
+func TestResolveCredentials(t *testing.T) {
+	ctx := context.Background()
+	resolve := func(t *testing.T, config ciscosecureaccessProviderModel) (string, string) {
+		t.Helper()
+		keyID, keySecret, diags := resolveCredentials(ctx, config)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return keyID, keySecret
+	}
+
+	t.Run("attributes override environment", func(t *testing.T) {
+		setupCredentials(t)
+		t.Setenv(envKeyID, "env-id")
+		t.Setenv(envKeySecret, "env-secret")
+		config := testCredentialsModel()
+		config.KeyID = types.StringValue("config-id")
+		if keyID, keySecret := resolve(t, config); keyID != "config-id" || keySecret != "env-secret" {
+			t.Errorf("got %s/%s, want config-id/env-secret", keyID, keySecret)
+		}
+	})
+
+	t.Run("secret file", func(t *testing.T) {
+		setupCredentials(t)
+		secretFile := filepath.Join(t.TempDir(), "secret")
+		if err := os.WriteFile(secretFile, []byte("  file-secret\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv(envKeyID, "env-id")
+		t.Setenv(envKeySecretFile, secretFile)
+		if keyID, keySecret := resolve(t, testCredentialsModel()); keyID != "env-id" || keySecret != "file-secret" {
+			t.Errorf("got %s/%s, want env-id/file-secret", keyID, keySecret)
+		}
+	})
+
+	t.Run("profile overrides environment", func(t *testing.T) {
+		credentialsFile := setupCredentials(t)
+		t.Setenv(envKeyID, "env-id")
+		t.Setenv(envKeySecret, "env-secret")
+		config := testCredentialsModel()
+		config.CredentialsFile = types.StringValue(credentialsFile)
+		config.Profile = types.StringValue("customer-a")
+		if keyID, keySecret := resolve(t, config); keyID != "customer-a-id" || keySecret != "customer-a-secret" {
+			t.Errorf("got %s/%s, want customer-a-id/customer-a-secret", keyID, keySecret)
+		}
+
+		// A profile without a secret is completed from the environment
+		config.Profile = types.StringValue("customer-b")
+		if keyID, keySecret := resolve(t, config); keyID != "customer-b-id" || keySecret != "env-secret" {
+			t.Errorf("got %s/%s, want customer-b-id/env-secret", keyID, keySecret)
+		}
+	})
+
+	t.Run("default profile is the last resort", func(t *testing.T) {
+		credentialsFile := setupCredentials(t)
+		t.Setenv(envCredentialsFile, credentialsFile)
+		if keyID, keySecret := resolve(t, testCredentialsModel()); keyID != "default-id" || keySecret != "default-secret" {
+			t.Errorf("got %s/%s, want default-id/default-secret", keyID, keySecret)
+		}
+
+		t.Setenv(envKeyID, "env-id")
+		if keyID, _ := resolve(t, testCredentialsModel()); keyID != "env-id" {
+			t.Errorf("got key ID %s, want env-id", keyID)
+		}
+	})
+
+	t.Run("missing default credentials file", func(t *testing.T) {
+		setupCredentials(t)
+		if keyID, keySecret := resolve(t, testCredentialsModel()); keyID != "" || keySecret != "" {
+			t.Errorf("got %s/%s, want no credentials", keyID, keySecret)
+		}
+	})
+
+	t.Run("missing profile", func(t *testing.T) {
+		credentialsFile := setupCredentials(t)
+		config := testCredentialsModel()
+		config.CredentialsFile = types.StringValue(credentialsFile)
+		config.Profile = types.StringValue("customer-z")
+		if _, _, diags := resolveCredentials(ctx, config); diags.ErrorsCount() != 1 {
+			t.Errorf("got %d errors, want 1: %v", diags.ErrorsCount(), diags)
+		}
+	})
+}
+
+func TestRunCredentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process tests use sh")
+	}
+	ctx := context.Background()
+
+	keyID, keySecret, err := runCredentialProcess(ctx, `echo '{"key_id": "process-id", "key_secret": "process-secret"}'`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keyID != "process-id" || keySecret != "process-secret" {
+		t.Errorf("got %s/%s, want process-id/process-secret", keyID, keySecret)
+	}
+
+	// Failures report stderr but never stdout, which may hold the secret
+	_, _, err = runCredentialProcess(ctx, `echo '{"key_secret": "leaked"}'; echo 'vault is sealed' >&2; exit 2`)
+	if err == nil || !strings.Contains(err.Error(), "vault is sealed") || strings.Contains(err.Error(), "leaked") {
+		t.Errorf("error = %v, want stderr without stdout", err)
+	}
+	_, _, err = runCredentialProcess(ctx, `echo '{"key_secret": "leaked"}'`)
+	if err == nil || strings.Contains(err.Error(), "leaked") {
+		t.Errorf("error = %v, want an incomplete output error without the secret", err)
+	}
+
+	// credential_process takes precedence over the environment
+	setupCredentials(t)
+	t.Setenv(envKeyID, "env-id")
+	t.Setenv(envCredentialProcess, `echo '{"key_id": "process-id", "key_secret": "process-secret"}'`)
+	keyID, _, diags := resolveCredentials(ctx, testCredentialsModel())
+	if diags.HasError() || keyID != "process-id" {
+		t.Errorf("got key ID %s (%v), want process-id", keyID, diags)
+	}
+}
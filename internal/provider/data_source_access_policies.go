@@ -0,0 +1,417 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/CiscoDevNet/go-ciscosecureaccess/rules"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	accessPolicyBatchSize = 100
+)
+
+var (
+	_ datasource.DataSource                   = &accessPoliciesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &accessPoliciesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &accessPoliciesDataSource{}
+)
+
+// NewAccessPoliciesDataSource creates the data source implementation.
+func NewAccessPoliciesDataSource() datasource.DataSource {
+	return &accessPoliciesDataSource{}
+}
+
+type accessPoliciesDataSource struct {
+	client rules.APIClient
+}
+
+// accessPoliciesDataSourceModel maps the data source schema data.
+type accessPoliciesDataSourceModel struct {
+	NameRegex      types.String `tfsdk:"name_regex"`
+	Action         types.String `tfsdk:"action"`
+	TrafficType    types.String `tfsdk:"traffic_type"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	AccessPolicies types.List   `tfsdk:"access_policies"`
+}
+
+// AttrTypes returns the object type of an access policy, shared by the
+// ciscosecureaccess_access_policies data source
+func (m accessPolicyResourceModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                         types.Int64Type,
+		"name":                       types.StringType,
+		"action":                     types.StringType,
+		"private_resource_ids":       types.SetType{ElemType: types.Int64Type},
+		"private_resource_group_ids": types.SetType{ElemType: types.Int64Type},
+		"destination_list_ids":       types.SetType{ElemType: types.Int64Type},
+		"content_category_list_ids":  types.SetType{ElemType: types.Int64Type},
+		"application_ids":            types.SetType{ElemType: types.Int64Type},
+		"application_list_ids":       types.SetType{ElemType: types.Int64Type},
+		"application_category_ids":   types.SetType{ElemType: types.Int64Type},
+		"description":                types.StringType,
+		"enabled":                    types.BoolType,
+		"log_level":                  types.StringType,
+		"priority":                   types.Int64Type,
+		"client_posture_profile_id":  types.Int64Type,
+		"security_profile_id":        types.Int64Type,
+		"ips_profile_id":             types.Int64Type,
+		"tenant_control_profile_id":  types.Int64Type,
+		"reauthentication_minutes":   types.Int64Type,
+		"source_ids":                 types.SetType{ElemType: types.Int64Type},
+		"source_types":               types.SetType{ElemType: types.StringType},
+		"private_destination_types":  types.SetType{ElemType: types.StringType},
+		"public_destination_types":   types.SetType{ElemType: types.StringType},
+		"traffic_type":               types.StringType,
+	}
+}
+
+// newAccessPolicyModel returns a model with every attribute null, ready to be
+// filled in by parseAccessPolicyRule
+func newAccessPolicyModel(id int64) accessPolicyResourceModel {
+	return accessPolicyResourceModel{
+		ID:                      types.Int64Value(id),
+		Name:                    types.StringNull(),
+		Action:                  types.StringNull(),
+		PrivateResourceIds:      types.SetNull(types.Int64Type),
+		PrivateResourceGroupIds: types.SetNull(types.Int64Type),
+		DestinationListIds:      types.SetNull(types.Int64Type),
+		ContentCategoryListIds:  types.SetNull(types.Int64Type),
+		ApplicationIds:          types.SetNull(types.Int64Type),
+		ApplicationListIds:      types.SetNull(types.Int64Type),
+		ApplicationCategoryIds:  types.SetNull(types.Int64Type),
+		Description:             types.StringNull(),
+		Enabled:                 types.BoolNull(),
+		LogLevel:                types.StringNull(),
+		Priority:                types.Int64Null(),
+		ClientPostureProfileId:  types.Int64Null(),
+		SecurityProfileId:       types.Int64Null(),
+		IpsProfileId:            types.Int64Null(),
+		TenantControlProfileId:  types.Int64Null(),
+		ReauthenticationMinutes: types.Int64Null(),
+		SourceIds:               types.SetNull(types.Int64Type),
+		SourceTypes:             types.SetNull(types.StringType),
+		PrivateDestinationTypes: types.SetNull(types.StringType),
+		PublicDestinationTypes:  types.SetNull(types.StringType),
+		TrafficType:             types.StringNull(),
+	}
+}
+
+func (d *accessPoliciesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_policies"
+}
+
+func (d *accessPoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	factory, ok := req.ProviderData.(*client.SSEClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data Type",
+			fmt.Sprintf("expected *client.SSEClientFactory, got %T", req.ProviderData))
+		return
+	}
+	d.client = *factory.GetRulesClient(ctx)
+}
+
+func (d *accessPoliciesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for retrieving Cisco Secure Access access policy rules, ordered by priority",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Optional regular expression matched against access policy names. If omitted, rules are not filtered by name.",
+				Optional:    true,
+			},
+			"action": schema.StringAttribute{
+				Description: "Only return rules with this action ('allow' or 'block')",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(accessPolicyResourceModel{}.Actions()...),
+				},
+			},
+			"traffic_type": schema.StringAttribute{
+				Description: "Only return rules with this traffic type ('PRIVATE_NETWORK' or 'PUBLIC_INTERNET')",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(accessPolicyResourceModel{}.TrafficTypes()...),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Only return enabled (true) or disabled (false) rules",
+				Optional:    true,
+			},
+			"access_policies": schema.ListNestedAttribute{
+				Description: "List of access policy rules matching the filters",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Unique ID of access policy",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of access policy",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action taken on matched traffic",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description for access policy",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether or not the access policy is enabled",
+							Computed:    true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "Priority of the rule (ascending)",
+							Computed:    true,
+						},
+						"traffic_type": schema.StringAttribute{
+							Description: "Traffic type defining the rule scope",
+							Computed:    true,
+						},
+						"log_level": schema.StringAttribute{
+							Description: "Level of logging performed on traffic matching the access policy",
+							Computed:    true,
+						},
+						"private_resource_ids": schema.SetAttribute{
+							Description: "Secure Access IDs of matching private resources",
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
+						"private_resource_group_ids": schema.SetAttribute{
+							Description: "Secure Access IDs of matching private resource groups",
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
+						"destination_list_ids": schema.SetAttribute{
+							Description: "Secure Access IDs of matching destination lists",
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
+						"content_category_list_ids": schema.SetAttribute{
+							Description: "Secure Access IDs of matching content category lists",
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
+						"application_ids": schema.SetAttribute{
+							Description: "Secure Access IDs of matching applications",
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
+						"application_list_ids": schema.SetAttribute{
+							Description: "Secure Access IDs of matching application lists",
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
+						"application_category_ids": schema.SetAttribute{
+							Description: "Secure Access IDs of matching application categories",
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
+						"source_ids": schema.SetAttribute{
+							Description: "Source Secure Access IDs of matching identities",
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
+						"source_types": schema.SetAttribute{
+							Description: "Wildcard source types matched by the rule",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"private_destination_types": schema.SetAttribute{
+							Description: "Wildcard private destination types matched by the rule",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"public_destination_types": schema.SetAttribute{
+							Description: "Wildcard public destination types matched by the rule",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"client_posture_profile_id": schema.Int64Attribute{
+							Description: "ID of posture profile for client-based access",
+							Computed:    true,
+						},
+						"security_profile_id": schema.Int64Attribute{
+							Description: "ID of the security profile applied to matching internet traffic",
+							Computed:    true,
+						},
+						"ips_profile_id": schema.Int64Attribute{
+							Description: "ID of the intrusion prevention (IPS) profile applied to matching traffic",
+							Computed:    true,
+						},
+						"tenant_control_profile_id": schema.Int64Attribute{
+							Description: "ID of the tenant control profile applied to matching internet traffic",
+							Computed:    true,
+						},
+						"reauthentication_minutes": schema.Int64Attribute{
+							Description: "Minutes after which users matching the rule must authenticate again",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *accessPoliciesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data accessPoliciesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.NameRegex.IsNull() || data.NameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(data.NameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Name Regex",
+			fmt.Sprintf("name_regex is not a valid regular expression: %s", err.Error()),
+		)
+	}
+}
+
+func (d *accessPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data accessPoliciesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading access policies", map[string]interface{}{
+		"name_regex":   data.NameRegex.ValueString(),
+		"action":       data.Action.ValueString(),
+		"traffic_type": data.TrafficType.ValueString(),
+	})
+
+	policies, getDiag := getAccessPolicies(ctx, &d.client, &data)
+	resp.Diagnostics.Append(getDiag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: accessPolicyResourceModel{}.AttrTypes()}, policies)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.AccessPolicies = listValue
+
+	tflog.Info(ctx, "Successfully retrieved access policies", map[string]interface{}{
+		"count": len(policies),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getAccessPolicies lists every rule and returns those matching the filters.
+// The list API omits conditions and settings, so each candidate rule is read
+// individually once the name, action and enabled filters have been applied.
+func getAccessPolicies(ctx context.Context, client *rules.APIClient, filters *accessPoliciesDataSourceModel) ([]accessPolicyResourceModel, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	var results []accessPolicyResourceModel
+
+	var nameRegex *regexp.Regexp
+	if !filters.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(filters.NameRegex.ValueString())
+		if err != nil {
+			diagnostics.AddError("Invalid Name Regex", err.Error())
+			return results, diagnostics
+		}
+	}
+
+	offset := int64(0)
+	for {
+		page, httpRes, err := client.AccessRulesAPI.ListRules(ctx).
+			Offset(offset).
+			Limit(accessPolicyBatchSize).
+			Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err != nil {
+			httpRespDetails := "HTTP response: <nil>"
+			if httpRes != nil {
+				httpRespDetails = fmt.Sprintf("HTTP response status: %d", httpRes.StatusCode)
+			}
+			diagnostics.AddError(
+				"Error listing access policies",
+				fmt.Sprintf("Could not retrieve access policies: %s\n%s", err.Error(), httpRespDetails),
+			)
+			return results, diagnostics
+		}
+
+		for _, summary := range page.Result {
+			if nameRegex != nil && !nameRegex.MatchString(summary.GetRuleName()) {
+				continue
+			}
+			if !filters.Action.IsNull() && string(summary.GetRuleAction()) != filters.Action.ValueString() {
+				continue
+			}
+			if !filters.Enabled.IsNull() && summary.GetRuleIsEnabled() != filters.Enabled.ValueBool() {
+				continue
+			}
+
+			rule, httpRes, err := client.AccessRulesAPI.GetRule(ctx, summary.RuleId).Execute()
+			if httpRes != nil {
+				httpRes.Body.Close()
+			}
+			if err != nil {
+				diagnostics.AddError(
+					"Error reading access policy",
+					fmt.Sprintf("Cannot read access policy ID %d: %s", summary.RuleId, err.Error()),
+				)
+				return results, diagnostics
+			}
+
+			policy := newAccessPolicyModel(summary.RuleId)
+			diagnostics.Append(parseAccessPolicyRule(ctx, rule, &policy)...)
+			if diagnostics.HasError() {
+				return results, diagnostics
+			}
+			if !filters.TrafficType.IsNull() && policy.TrafficType.ValueString() != filters.TrafficType.ValueString() {
+				continue
+			}
+
+			tflog.Trace(ctx, "Processing access policy", map[string]interface{}{
+				"id":   summary.RuleId,
+				"name": summary.GetRuleName(),
+			})
+			results = append(results, policy)
+		}
+
+		offset += int64(len(page.Result))
+		if int64(len(page.Result)) < accessPolicyBatchSize || offset >= page.GetCount() {
+			break
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Priority.ValueInt64() < results[j].Priority.ValueInt64()
+	})
+
+	return results, diagnostics
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+const testAccessPoliciesDataSourceName = "data.ciscosecureaccess_access_policies.test"
+
+// --- Acceptance tests (require TF_ACC + CISCOSECUREACCESS_KEY_ID/SECRET) ---
+
+func TestAccessPoliciesDataSource_basic(t *testing.T) {
+	rateLimitedTest(t, func() {
+		testName := generateAccessPolicyTestName("list")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccAccessPoliciesDataSourceConfig(testName),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(testAccessPoliciesDataSourceName, tfjsonpath.New("access_policies"), knownvalue.ListSizeExact(1)),
+						statecheck.ExpectKnownValue(testAccessPoliciesDataSourceName, tfjsonpath.New("access_policies").AtSliceIndex(0).AtMapKey("name"), knownvalue.StringExact(testName+"_internet")),
+						statecheck.ExpectKnownValue(testAccessPoliciesDataSourceName, tfjsonpath.New("access_policies").AtSliceIndex(0).AtMapKey("traffic_type"), knownvalue.StringExact("PUBLIC_INTERNET")),
+						statecheck.ExpectKnownValue(testAccessPoliciesDataSourceName, tfjsonpath.New("access_policies").AtSliceIndex(0).AtMapKey("public_destination_types"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact(PUBLIC_INTERNET_SCHEMA)})),
+						statecheck.ExpectKnownValue(testAccessPoliciesDataSourceName, tfjsonpath.New("access_policies").AtSliceIndex(0).AtMapKey("private_resource_ids"), knownvalue.Null()),
+					},
+					Check: resource.TestCheckResourceAttrPair(
+						testAccessPoliciesDataSourceName, "access_policies.0.id",
+						"ciscosecureaccess_access_policy.internet", "id",
+					),
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+// testAccAccessPoliciesDataSourceConfig returns a private and an internet
+// rule sharing a name prefix, and a data source selecting the internet rule
+func testAccAccessPoliciesDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ciscosecureaccess_access_policy" "private" {
+    name = "%[1]s_private"
+    action = "allow"
+    enabled = true
+    source_types = ["networks"]
+    private_destination_types = ["private_apps"]
+}
+
+resource "ciscosecureaccess_access_policy" "internet" {
+    name = "%[1]s_internet"
+    action = "allow"
+    enabled = true
+    traffic_type = "PUBLIC_INTERNET"
+    source_types = ["directory_users"]
+    public_destination_types = ["internet"]
+}
+
+data "ciscosecureaccess_access_policies" "test" {
+    name_regex   = "^%[1]s_"
+    action       = "allow"
+    traffic_type = "PUBLIC_INTERNET"
+    enabled      = true
+
+    depends_on = [
+        ciscosecureaccess_access_policy.private,
+        ciscosecureaccess_access_policy.internet,
+    ]
+}`, name)
+}
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+// TestGetAccessPolicies_filters runs getAccessPolicies against a dedicated
+// fake API seeded with rules that each fail exactly one filter
+func TestGetAccessPolicies_filters(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeAPIServer()
+	defer fake.Close()
+
+	factory := &client.SSEClientFactory{
+		KeyId:         fakeAPIKeyID,
+		KeySecret:     fakeAPIKeySecret,
+		ApiEndpoint:   fake.Endpoint(),
+		SSEHttpClient: fake.HTTPClient(),
+	}
+	rulesClient := factory.GetRulesClient(ctx)
+
+	seed := func(name, action, trafficType string, enabled bool, priority int64) int64 {
+		t.Helper()
+		plan := newAccessPolicyModel(0)
+		plan.Name = types.StringValue(name)
+		plan.Action = types.StringValue(action)
+		plan.Enabled = types.BoolValue(enabled)
+		plan.LogLevel = types.StringValue("LOG_ALL")
+		plan.TrafficType = types.StringValue(trafficType)
+		plan.Priority = types.Int64Value(priority)
+		plan.SourceTypes = types.SetValueMust(types.StringType, []attr.Value{types.StringValue(DIRECTORY_USERS)})
+		plan.PublicDestinationTypes = types.SetValueMust(types.StringType, []attr.Value{types.StringValue(PUBLIC_INTERNET_SCHEMA)})
+
+		rule, _, err := rulesClient.AccessRulesAPI.AddRule(ctx).AddRuleRequest(*formatCreateAccessPolicyRequest(ctx, &plan)).Execute()
+		if err != nil {
+			t.Fatalf("seeding rule %s: %v", name, err)
+		}
+		return rule.GetRuleId()
+	}
+
+	second := seed("audit-second", "allow", "PUBLIC_INTERNET", true, 5)
+	first := seed("audit-first", "allow", "PUBLIC_INTERNET", true, 2)
+	seed("audit-blocked", "block", "PUBLIC_INTERNET", true, 3)
+	seed("audit-disabled", "allow", "PUBLIC_INTERNET", false, 4)
+	seed("audit-private", "allow", "PRIVATE_NETWORK", true, 1)
+	seed("other", "allow", "PUBLIC_INTERNET", true, 6)
+
+	filters := &accessPoliciesDataSourceModel{
+		NameRegex:   types.StringValue("^audit-"),
+		Action:      types.StringValue("allow"),
+		TrafficType: types.StringValue("PUBLIC_INTERNET"),
+		Enabled:     types.BoolValue(true),
+	}
+	results, diags := getAccessPolicies(ctx, rulesClient, filters)
+	if diags.HasError() {
+		t.Fatalf("expected no diagnostics, got: %v", diags)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("got %d access policies, want 2: %+v", len(results), results)
+	}
+	// Results are ordered by priority, not creation order
+	if results[0].ID.ValueInt64() != first || results[1].ID.ValueInt64() != second {
+		t.Errorf("got IDs %d, %d, want %d, %d", results[0].ID.ValueInt64(), results[1].ID.ValueInt64(), first, second)
+	}
+	if results[0].Name.ValueString() != "audit-first" || results[0].LogLevel.ValueString() != "LOG_ALL" {
+		t.Errorf("rule not parsed: %+v", results[0])
+	}
+	if !results[0].PrivateResourceIds.IsNull() {
+		t.Errorf("private_resource_ids = %s, want null", results[0].PrivateResourceIds)
+	}
+
+	// Without filters every rule is returned
+	results, diags = getAccessPolicies(ctx, rulesClient, &accessPoliciesDataSourceModel{})
+	if diags.HasError() {
+		t.Fatalf("expected no diagnostics, got: %v", diags)
+	}
+	if len(results) != 6 {
+		t.Errorf("got %d access policies without filters, want 6", len(results))
+	}
+}
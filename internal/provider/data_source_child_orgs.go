@@ -0,0 +1,253 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// childOrgsPath lists the child organizations (customers) of a Managed
+// Service Provider organization. The SDK has no client for it.
+const childOrgsPath = "admin/v2/managed/customers"
+
+var (
+	_ datasource.DataSource                   = &childOrgsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &childOrgsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &childOrgsDataSource{}
+)
+
+// NewChildOrgsDataSource creates the data source implementation.
+func NewChildOrgsDataSource() datasource.DataSource {
+	return &childOrgsDataSource{}
+}
+
+type childOrgsDataSource struct {
+	httpClient *http.Client
+	url        string
+}
+
+// childOrgModel maps a single child organization entry.
+type childOrgModel struct {
+	Id        types.Int64  `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Seats     types.Int64  `tfsdk:"seats"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (m childOrgModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.Int64Type,
+		"name":       types.StringType,
+		"seats":      types.Int64Type,
+		"created_at": types.StringType,
+	}
+}
+
+// childOrgsDataSourceModel maps the data source schema data.
+type childOrgsDataSourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	ChildOrgs types.List   `tfsdk:"child_orgs"`
+}
+
+// childOrgResponse is a child organization as returned by the API
+type childOrgResponse struct {
+	CustomerId   int64  `json:"customerId"`
+	CustomerName string `json:"customerName"`
+	Seats        int64  `json:"seats"`
+	CreatedAt    string `json:"createdAt"`
+}
+
+func (d *childOrgsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_child_orgs"
+}
+
+func (d *childOrgsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	factory, ok := req.ProviderData.(*client.SSEClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data Type",
+			fmt.Sprintf("expected *client.SSEClientFactory, got %T", req.ProviderData))
+		return
+	}
+	d.httpClient = factory.GetHttpClient(ctx)
+	d.url = factory.GetURLString(childOrgsPath)
+}
+
+func (d *childOrgsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for retrieving the child organizations of a Managed Service Provider organization. " +
+			"The provider must be configured with the parent organization API key and without child_org_id.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Optional regular expression matched against child organization names. If omitted, all child organizations are returned.",
+				Optional:    true,
+			},
+			"child_orgs": schema.ListNestedAttribute{
+				Description: "List of child organizations matching the filter, ordered by name",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Organization ID of the child organization, for use as the provider child_org_id",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the child organization",
+							Computed:    true,
+						},
+						"seats": schema.Int64Attribute{
+							Description: "Number of seats licensed to the child organization",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Date and time the child organization was created",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *childOrgsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data childOrgsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.NameRegex.IsNull() || data.NameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(data.NameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Name Regex",
+			fmt.Sprintf("name_regex is not a valid regular expression: %s", err.Error()),
+		)
+	}
+}
+
+func (d *childOrgsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data childOrgsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading child organizations", map[string]interface{}{
+		"name_regex": data.NameRegex.ValueString(),
+	})
+
+	orgs, getDiag := getChildOrgs(ctx, d.httpClient, d.url, data.NameRegex)
+	resp.Diagnostics.Append(getDiag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: childOrgModel{}.AttrTypes()}, orgs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ChildOrgs = listValue
+
+	tflog.Info(ctx, "Successfully retrieved child organizations", map[string]interface{}{
+		"count": len(orgs),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getChildOrgs lists the child organizations visible to the API key and
+// returns those whose name matches nameRegex, ordered by name
+func getChildOrgs(ctx context.Context, httpClient *http.Client, url string, nameRegex types.String) ([]childOrgModel, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	var results []childOrgModel
+
+	var re *regexp.Regexp
+	if !nameRegex.IsNull() {
+		var err error
+		re, err = regexp.Compile(nameRegex.ValueString())
+		if err != nil {
+			diagnostics.AddError("Invalid Name Regex", err.Error())
+			return results, diagnostics
+		}
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		diagnostics.AddError("Error listing child organizations", err.Error())
+		return results, diagnostics
+	}
+	httpReq.Header.Set("Accept", "application/json")
+
+	httpRes, err := httpClient.Do(httpReq)
+	if err != nil {
+		diagnostics.AddError("Error listing child organizations", err.Error())
+		return results, diagnostics
+	}
+	defer httpRes.Body.Close()
+
+	body, err := io.ReadAll(httpRes.Body)
+	if err != nil {
+		diagnostics.AddError("Error listing child organizations", fmt.Sprintf("Failed to read response: %s", err.Error()))
+		return results, diagnostics
+	}
+
+	switch {
+	case httpRes.StatusCode == http.StatusForbidden:
+		diagnostics.AddError(
+			"Error listing child organizations",
+			fmt.Sprintf("HTTP %s: %s. Child organizations can only be listed with the API key of a Managed Service Provider organization, "+
+				"from a provider configured without child_org_id.", httpRes.Status, string(body)),
+		)
+		return results, diagnostics
+	case httpRes.StatusCode != http.StatusOK:
+		diagnostics.AddError("Error listing child organizations", fmt.Sprintf("HTTP %s: %s", httpRes.Status, string(body)))
+		return results, diagnostics
+	}
+
+	var orgs []childOrgResponse
+	if err := json.Unmarshal(body, &orgs); err != nil {
+		diagnostics.AddError("Error listing child organizations", fmt.Sprintf("Failed to decode response: %s", err.Error()))
+		return results, diagnostics
+	}
+
+	for _, org := range orgs {
+		if re != nil && !re.MatchString(org.CustomerName) {
+			continue
+		}
+		results = append(results, childOrgModel{
+			Id:        types.Int64Value(org.CustomerId),
+			Name:      types.StringValue(org.CustomerName),
+			Seats:     types.Int64Value(org.Seats),
+			CreatedAt: types.StringValue(org.CreatedAt),
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Name.ValueString() < results[j].Name.ValueString()
+	})
+
+	return results, diagnostics
+}
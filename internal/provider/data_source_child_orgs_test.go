@@ -0,0 +1,71 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+func TestGetChildOrgs_filtersAndSorts(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeAPIServer()
+	defer fake.Close()
+
+	factory := &client.SSEClientFactory{
+		KeyId:         fakeAPIKeyID,
+		KeySecret:     fakeAPIKeySecret,
+		ApiEndpoint:   fake.Endpoint(),
+		SSEHttpClient: fake.HTTPClient(),
+	}
+	httpClient := factory.GetHttpClient(ctx)
+	url := factory.GetURLString(childOrgsPath)
+
+	results, diags := getChildOrgs(ctx, httpClient, url, types.StringNull())
+	if diags.HasError() {
+		t.Fatalf("expected no diagnostics, got: %v", diags)
+	}
+	var names []string
+	for _, org := range results {
+		names = append(names, org.Name.ValueString())
+	}
+	if len(names) != 3 || names[0] != "Acme Corp" || names[1] != "Globex" || names[2] != "Initech" {
+		t.Fatalf("got child orgs %v, want [Acme Corp Globex Initech]", names)
+	}
+	if results[0].Id.ValueInt64() != fakeAPIOrgID+2 || results[0].Seats.ValueInt64() != 200 || results[0].CreatedAt.ValueString() == "" {
+		t.Errorf("child org not parsed: %+v", results[0])
+	}
+
+	results, diags = getChildOrgs(ctx, httpClient, url, types.StringValue("^(Acme|Initech)"))
+	if diags.HasError() {
+		t.Fatalf("expected no diagnostics, got: %v", diags)
+	}
+	if len(results) != 2 {
+		t.Errorf("got %d child orgs matching name_regex, want 2: %+v", len(results), results)
+	}
+}
+
+func TestGetChildOrgs_forbidden(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Forbidden"}`, http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	_, diags := getChildOrgs(context.Background(), server.Client(), server.URL, types.StringNull())
+	if !diags.HasError() {
+		t.Fatal("expected an error for a 403 response")
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "child_org_id") {
+		t.Errorf("error detail %q does not mention child_org_id", detail)
+	}
+}
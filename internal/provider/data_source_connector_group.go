@@ -57,7 +57,7 @@ func (d *resourceConnectorGroupsDataSource) Schema(ctx context.Context, req data
 							Computed:    true,
 						},
 						"provisioning_key": schema.StringAttribute{
-							Description: "Provisioning key for adding resource connectors to group",
+							Description: "Provisioning key for adding resource connectors to group. It is stored in state; use the ciscosecureaccess_resource_connector_provisioning_key ephemeral resource to keep it out of state",
 							Computed:    true,
 							Sensitive:   true,
 						},
@@ -0,0 +1,212 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/CiscoDevNet/go-ciscosecureaccess/ntg"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &datacentersDataSource{}
+	_ datasource.DataSourceWithConfigure = &datacentersDataSource{}
+)
+
+// NewDatacentersDataSource creates the data source implementation.
+func NewDatacentersDataSource() datasource.DataSource {
+	return &datacentersDataSource{}
+}
+
+type datacentersDataSource struct {
+	client ntg.APIClient
+}
+
+// datacentersDataSourceModel maps the data source schema data.
+type datacentersDataSourceModel struct {
+	Region      types.String `tfsdk:"region"`
+	Datacenters types.List   `tfsdk:"datacenters"`
+}
+
+// datacenterStateModel maps a single datacenter hosting hubs.
+type datacenterStateModel struct {
+	Name   types.String `tfsdk:"name"`
+	IP     types.String `tfsdk:"ip"`
+	Region types.String `tfsdk:"region"`
+}
+
+func (m datacenterStateModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":   types.StringType,
+		"ip":     types.StringType,
+		"region": types.StringType,
+	}
+}
+
+func (d *datacentersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datacenters"
+}
+
+func (d *datacentersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	factory, ok := req.ProviderData.(*client.SSEClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data Type",
+			fmt.Sprintf("expected *client.SSEClientFactory, got %T", req.ProviderData))
+		return
+	}
+	d.client = *factory.GetNtgClient(ctx)
+}
+
+func (d *datacentersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for retrieving the Secure Access datacenters hosting the hubs of the network tunnel groups of the organization, " +
+			"with the public IP addresses devices connect to. The API does not list datacenters without hubs of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Description: "Optional region of network tunnel groups. If omitted, the datacenters of all regions are returned.",
+				Optional:    true,
+			},
+			"datacenters": schema.ListNestedAttribute{
+				Description: "List of datacenters, ordered by region and name",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of datacenter",
+							Computed:    true,
+						},
+						"ip": schema.StringAttribute{
+							Description: "Public IP address of datacenter that devices connect their tunnels to",
+							Computed:    true,
+						},
+						"region": schema.StringAttribute{
+							Description: "Region of the network tunnel groups with hubs in datacenter",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *datacentersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datacentersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datacenters, err := d.listDatacenters(ctx, data.Region.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing datacenters",
+			fmt.Sprintf("Could not list the datacenters of network tunnel groups: %s", err.Error()),
+		)
+		return
+	}
+
+	datacenterList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datacenterStateModel{}.AttrTypes()}, datacenters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Datacenters = datacenterList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listDatacenters collects the datacenters of the hubs of all network tunnel
+// groups in region, or in every region when region is empty. The list
+// response carries no datacenter IPs, so a tunnel group is read whenever it
+// has a hub in a datacenter not seen yet.
+func (d *datacentersDataSource) listDatacenters(ctx context.Context, region string) ([]datacenterStateModel, error) {
+	seen := map[[2]string]bool{}
+	datacenters := make([]datacenterStateModel, 0)
+
+	offset := int64(0)
+	for {
+		listResp, _, err := d.client.NetworkTunnelGroupsAPI.ListNetworkTunnelGroups(ctx).Offset(offset).Limit(importLookupPageLimit).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range listResp.Data {
+			if region != "" && group.GetRegion() != region {
+				continue
+			}
+			if !hasUnseenDatacenter(group, seen) {
+				continue
+			}
+
+			groupResp, _, err := d.client.NetworkTunnelGroupsAPI.GetNetworkTunnelGroup(ctx, group.GetId()).Execute()
+			if err != nil {
+				return nil, fmt.Errorf("reading network tunnel group ID %d: %w", group.GetId(), err)
+			}
+			datacenters = appendDatacenters(datacenters, groupResp, seen)
+		}
+
+		offset += int64(len(listResp.Data))
+		if len(listResp.Data) < importLookupPageLimit || (listResp.Total != nil && offset >= *listResp.Total) {
+			break
+		}
+	}
+
+	sortDatacenters(datacenters)
+	tflog.Debug(ctx, "Collected datacenters of network tunnel groups", map[string]interface{}{"count": len(datacenters)})
+	return datacenters, nil
+}
+
+// hasUnseenDatacenter reports whether group has a hub in a datacenter that
+// is not in seen
+func hasUnseenDatacenter(group ntg.NetworkTunnelGroupListResponse, seen map[[2]string]bool) bool {
+	for _, hub := range group.Hubs {
+		datacenter := hub.GetDatacenter()
+		if !seen[[2]string{group.GetRegion(), datacenter.GetName()}] {
+			return true
+		}
+	}
+	return false
+}
+
+// appendDatacenters appends the datacenters of the hubs of group that are
+// not in seen, and marks them seen
+func appendDatacenters(datacenters []datacenterStateModel, group *ntg.NetworkTunnelGroupResponse, seen map[[2]string]bool) []datacenterStateModel {
+	for _, hub := range group.Hubs {
+		datacenter := hub.GetDatacenter()
+		key := [2]string{group.GetRegion(), datacenter.GetName()}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		datacenters = append(datacenters, datacenterStateModel{
+			Name:   types.StringValue(datacenter.GetName()),
+			IP:     types.StringValue(datacenter.GetIp()),
+			Region: types.StringValue(group.GetRegion()),
+		})
+	}
+	return datacenters
+}
+
+// sortDatacenters orders datacenters by region and name
+func sortDatacenters(datacenters []datacenterStateModel) {
+	sort.Slice(datacenters, func(i, j int) bool {
+		if datacenters[i].Region.ValueString() != datacenters[j].Region.ValueString() {
+			return datacenters[i].Region.ValueString() < datacenters[j].Region.ValueString()
+		}
+		return datacenters[i].Name.ValueString() < datacenters[j].Name.ValueString()
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/ntg"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+const testDatacentersDataSourceName = "data.ciscosecureaccess_datacenters.test"
+
+func TestDatacentersDataSource_region(t *testing.T) {
+	rateLimitedTest(t, func() {
+		testName := generateNTGTestName("datacenters")
+		identifierPrefix := generateNTGIdentifierPrefix("datacenters")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccNTGBasicConfig(testName, identifierPrefix) + `
+data "ciscosecureaccess_datacenters" "test" {
+  region     = ciscosecureaccess_network_tunnel_group.test_resource.region
+  depends_on = [ciscosecureaccess_network_tunnel_group.test_resource]
+}
+`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet(testDatacentersDataSourceName, "datacenters.0.name"),
+						resource.TestCheckResourceAttrSet(testDatacentersDataSourceName, "datacenters.0.ip"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(testDatacentersDataSourceName, tfjsonpath.New("datacenters").AtSliceIndex(0).AtMapKey("region"),
+							knownvalue.StringExact(testNTGRegion)),
+					},
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+func TestAppendDatacenters(t *testing.T) {
+	var listed ntg.NetworkTunnelGroupListResponse
+	if err := json.Unmarshal([]byte(`{
+		"id": 7, "region": "us-test-2",
+		"hubs": [{"id": 11, "datacenter": {"name": "us-test-2-2"}}, {"id": 12, "datacenter": {"name": "us-test-2-1"}}]
+	}`), &listed); err != nil {
+		t.Fatalf("decoding listed tunnel group: %v", err)
+	}
+	var group ntg.NetworkTunnelGroupResponse
+	if err := json.Unmarshal([]byte(`{
+		"id": 7, "region": "us-test-2",
+		"hubs": [
+			{"id": 11, "datacenter": {"name": "us-test-2-2", "ip": "203.0.113.5"}},
+			{"id": 12, "datacenter": {"name": "us-test-2-1", "ip": "203.0.113.4"}}
+		]
+	}`), &group); err != nil {
+		t.Fatalf("decoding tunnel group: %v", err)
+	}
+
+	seen := map[[2]string]bool{}
+	if !hasUnseenDatacenter(listed, seen) {
+		t.Fatal("no unseen datacenter before the first group was read")
+	}
+	datacenters := appendDatacenters(nil, &group, seen)
+	if hasUnseenDatacenter(listed, seen) {
+		t.Error("unseen datacenter after the group was read")
+	}
+	if datacenters = appendDatacenters(datacenters, &group, seen); len(datacenters) != 2 {
+		t.Fatalf("got %d datacenters, want each datacenter once", len(datacenters))
+	}
+
+	// The same datacenter name in another region is another datacenter
+	listed.SetRegion("eu-test-1")
+	if !hasUnseenDatacenter(listed, seen) {
+		t.Error("datacenter of another region counted as seen")
+	}
+
+	sortDatacenters(datacenters)
+	want := datacenterStateModel{
+		Name:   types.StringValue("us-test-2-1"),
+		IP:     types.StringValue("203.0.113.4"),
+		Region: types.StringValue("us-test-2"),
+	}
+	if datacenters[0] != want {
+		t.Errorf("first datacenter = %+v, want %+v", datacenters[0], want)
+	}
+}
@@ -7,11 +7,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
 	"github.com/CiscoDevNet/go-ciscosecureaccess/reports"
-	"github.com/avast/retry-go/v4"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -155,7 +153,7 @@ func (d *identityDataSource) Read(ctx context.Context, req datasource.ReadReques
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-// getIdentitiesForFilter retrieves identities from the API with pagination and retry logic.
+// getIdentitiesForFilter retrieves identities from the API with pagination.
 func getIdentitiesForFilter(ctx context.Context, client *reports.APIClient, filter string, identityType string) ([]IdentityModel, diag.Diagnostics) {
 	offset := int64(0)
 	var diagnostics diag.Diagnostics
@@ -167,75 +165,48 @@ func getIdentitiesForFilter(ctx context.Context, client *reports.APIClient, filt
 	})
 
 	for {
-		done := false
-		err := retry.Do(
-			func() error {
-				identitiesResp, httpRes, err := client.UtilityAPI.GetIdentities(ctx).
-					Limit(identityBatchSize).
-					Offset(offset).
-					Search(fmt.Sprintf("%%%s%%", filter)).
-					Identitytypes(identityType).
-					Execute()
-
-				if err != nil {
-					var httpRespDetails string
-					if httpRes != nil {
-						httpRespDetails = fmt.Sprintf("HTTP response status: %d", httpRes.StatusCode)
-					} else {
-						httpRespDetails = "HTTP response: <nil>"
-					}
-
-					if httpRes != nil && httpRes.StatusCode == 429 {
-						tflog.Warn(ctx, "Rate limited, retrying", map[string]interface{}{
-							"offset": offset,
-						})
-						return err
-					} else {
-						diagnostics.AddError(
-							"Error listing identity/group source",
-							fmt.Sprintf("Could not retrieve identities: %s\n%v", err.Error(), httpRespDetails),
-						)
-						done = true
-						return retry.Unrecoverable(err)
-					}
-				}
-
-				// Process the batch of identities
-				for _, identity := range identitiesResp.Data {
-					tflog.Trace(ctx, "Processing identity", map[string]interface{}{
-						"id":    identity.Id,
-						"label": identity.Label,
-						"type":  *identity.Type.Type,
-					})
-
-					identities = append(identities, IdentityModel{
-						Id:    types.Int64Value(identity.Id),
-						Label: types.StringValue(identity.Label),
-						Type:  types.StringValue(*identity.Type.Type),
-					})
-				}
-
-				// Check if we have more data to fetch
-				if len(identitiesResp.Data) < identityBatchSize {
-					done = true
-				}
-				offset += identityBatchSize
-				return nil
-			},
-			retry.Delay(time.Second*10), // Reasonable retry delay
-			retry.Attempts(3),           // Limit retry attempts
-		)
-
-		if err != nil && !diagnostics.HasError() {
+		// Rate limiting (429) is retried by the HTTP client
+		identitiesResp, httpRes, err := client.UtilityAPI.GetIdentities(ctx).
+			Limit(identityBatchSize).
+			Offset(offset).
+			Search(fmt.Sprintf("%%%s%%", filter)).
+			Identitytypes(identityType).
+			Execute()
+
+		if err != nil {
+			var httpRespDetails string
+			if httpRes != nil {
+				httpRespDetails = fmt.Sprintf("HTTP response status: %d", httpRes.StatusCode)
+			} else {
+				httpRespDetails = "HTTP response: <nil>"
+			}
 			diagnostics.AddError(
-				"Failed to retrieve identities after retries",
-				fmt.Sprintf("API request failed: %s", err.Error()),
+				"Error listing identity/group source",
+				fmt.Sprintf("Could not retrieve identities: %s\n%v", err.Error(), httpRespDetails),
 			)
+			break
+		}
+
+		// Process the batch of identities
+		for _, identity := range identitiesResp.Data {
+			tflog.Trace(ctx, "Processing identity", map[string]interface{}{
+				"id":    identity.Id,
+				"label": identity.Label,
+				"type":  *identity.Type.Type,
+			})
+
+			identities = append(identities, IdentityModel{
+				Id:    types.Int64Value(identity.Id),
+				Label: types.StringValue(identity.Label),
+				Type:  types.StringValue(*identity.Type.Type),
+			})
 		}
 
-		if done {
+		// Check if we have more data to fetch
+		if len(identitiesResp.Data) < identityBatchSize {
 			break
 		}
+		offset += identityBatchSize
 	}
 
 	tflog.Debug(ctx, "Completed identity retrieval", map[string]interface{}{
@@ -0,0 +1,477 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/CiscoDevNet/go-ciscosecureaccess/ntg"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Device platforms network tunnel group configuration is rendered for
+const (
+	ntgPlatformIOS        = "ios"
+	ntgPlatformMerakiMX   = "meraki_mx"
+	ntgPlatformStrongSwan = "strongswan"
+)
+
+// ntgPlatforms lists the platforms in the order the documentation names them
+var ntgPlatforms = []string{ntgPlatformIOS, ntgPlatformMerakiMX, ntgPlatformStrongSwan}
+
+// ntgDevicePlatforms maps the device types of network tunnel groups to the
+// platform rendered for them when platform is not configured. ASA, FTD and
+// Viptela devices are configured through their managers, and tunnels of
+// "other" devices need an explicit platform.
+var ntgDevicePlatforms = map[ntg.DeviceType]string{
+	ntg.ISR:       ntgPlatformIOS,
+	ntg.MERAKI_MX: ntgPlatformMerakiMX,
+}
+
+// ntgDefaultTunnelSources are the tunnel_source defaults per platform
+var ntgDefaultTunnelSources = map[string]string{
+	ntgPlatformIOS:        "GigabitEthernet1",
+	ntgPlatformStrongSwan: "%any",
+}
+
+var (
+	_ datasource.DataSource              = &networkTunnelGroupConfigDataSource{}
+	_ datasource.DataSourceWithConfigure = &networkTunnelGroupConfigDataSource{}
+)
+
+// NewNetworkTunnelGroupConfigDataSource creates the data source implementation.
+func NewNetworkTunnelGroupConfigDataSource() datasource.DataSource {
+	return &networkTunnelGroupConfigDataSource{}
+}
+
+type networkTunnelGroupConfigDataSource struct {
+	client ntg.APIClient
+}
+
+// ntgConfigDataSourceModel maps the data source schema data.
+type ntgConfigDataSourceModel struct {
+	Id           types.Int64  `tfsdk:"id"`
+	PresharedKey types.String `tfsdk:"preshared_key"`
+	Platform     types.String `tfsdk:"platform"`
+	TunnelSource types.String `tfsdk:"tunnel_source"`
+	TunnelRoutes types.List   `tfsdk:"tunnel_routes"`
+	WANNextHop   types.String `tfsdk:"wan_next_hop"`
+	Config       types.String `tfsdk:"config"`
+}
+
+// ntgDeviceConfig is the input of the device configuration templates
+type ntgDeviceConfig struct {
+	Name          string
+	PresharedKey  string
+	TunnelSource  string
+	TunnelRoutes  []string
+	WANNextHop    string
+	RoutingType   string
+	LocalNetworks []string
+	Hubs          []ntgDeviceConfigHub
+}
+
+// ntgDeviceConfigHub is a hub of the tunnel group, primary hub first. Key
+// names the configuration objects of the hub; it includes the hub ID, since
+// a datacenter can host several hubs of the group.
+type ntgDeviceConfigHub struct {
+	ID         int64
+	Key        string
+	Datacenter string
+	IP         string
+	AuthID     string
+	IsPrimary  bool
+}
+
+func (d *networkTunnelGroupConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_tunnel_group_config"
+}
+
+func (d *networkTunnelGroupConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	factory, ok := req.ProviderData.(*client.SSEClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data Type",
+			fmt.Sprintf("expected *client.SSEClientFactory, got %T", req.ProviderData))
+		return
+	}
+	d.client = *factory.GetNtgClient(ctx)
+}
+
+func (d *networkTunnelGroupConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source rendering the IKEv2/IPsec configuration that connects a device to the hubs of a network tunnel group",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "Unique ID of network tunnel group",
+				Required:    true,
+			},
+			"preshared_key": schema.StringAttribute{
+				Description: "Preshared key of network tunnel group. It cannot be read from the API.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"platform": schema.StringAttribute{
+				Description: "Platform to render the configuration for: ios (Cisco ISR and other IOS XE routers), meraki_mx " +
+					"(third-party VPN peers for the Meraki Dashboard API) or strongswan (swanctl.conf). Defaults to ios for ISR and " +
+					"meraki_mx for Meraki MX tunnel groups and is required for other device types.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ntgPlatforms...),
+				},
+			},
+			"tunnel_source": schema.StringAttribute{
+				Description: "Where the tunnels originate on the device: the WAN interface for ios (default GigabitEthernet1) " +
+					"or the local address for strongswan (default %any). Ignored for meraki_mx.",
+				Optional: true,
+			},
+			"tunnel_routes": schema.ListAttribute{
+				Description: "Destination CIDRs ios routes over the tunnels, through the secondary hubs while the primary tunnel is down. " +
+					"No routes are rendered when unset. Ignored for the other platforms.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"wan_next_hop": schema.StringAttribute{
+				Description: "Next hop of tunnel_source, through which ios keeps routing the hub addresses when tunnel_routes is set. " +
+					"Defaults to the gateway learned by DHCP on tunnel_source. Ignored for the other platforms.",
+				Optional: true,
+			},
+			"config": schema.StringAttribute{
+				Description: "Rendered device configuration, which contains the preshared key",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (d *networkTunnelGroupConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ntgConfigDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunnelId := data.Id.ValueInt64()
+	tflog.Debug(ctx, "Rendering network tunnel group configuration", map[string]interface{}{"id": tunnelId})
+
+	group, _, err := d.client.NetworkTunnelGroupsAPI.GetNetworkTunnelGroup(ctx, tunnelId).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading network tunnel group",
+			fmt.Sprintf("Could not read network tunnel group ID %d: %s", tunnelId, err.Error()),
+		)
+		return
+	}
+
+	platform := data.Platform.ValueString()
+	if data.Platform.IsNull() {
+		var ok bool
+		if platform, ok = ntgDevicePlatforms[group.GetDeviceType()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("platform"),
+				"Missing Platform",
+				fmt.Sprintf("Network tunnel group %q has device type %q, which has no default platform. Set platform to one of %s.",
+					group.GetName(), group.GetDeviceType(), strings.Join(ntgPlatforms, ", ")),
+			)
+			return
+		}
+	}
+
+	var tunnelRoutes []string
+	resp.Diagnostics.Append(data.TunnelRoutes.ElementsAs(ctx, &tunnelRoutes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, route := range tunnelRoutes {
+		if _, _, err := net.ParseCIDR(route); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tunnel_routes"),
+				"Invalid Tunnel Route",
+				fmt.Sprintf("%q is not a CIDR: %s", route, err.Error()),
+			)
+			return
+		}
+	}
+
+	deviceConfig := newNTGDeviceConfig(group, data)
+	deviceConfig.TunnelRoutes = tunnelRoutes
+	config, err := renderNTGDeviceConfig(platform, deviceConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rendering network tunnel group configuration",
+			fmt.Sprintf("Could not render %s configuration for network tunnel group ID %d: %s", platform, tunnelId, err.Error()),
+		)
+		return
+	}
+
+	data.Platform = types.StringValue(platform)
+	data.Config = types.StringValue(config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ntgConfigKeyPattern matches the characters not allowed in the names of
+// generated configuration objects
+var ntgConfigKeyPattern = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// newNTGDeviceConfig collects the template input from a network tunnel group
+func newNTGDeviceConfig(group *ntg.NetworkTunnelGroupResponse, data ntgConfigDataSourceModel) ntgDeviceConfig {
+	routing := group.GetRouting()
+	config := ntgDeviceConfig{
+		Name:          group.GetName(),
+		PresharedKey:  data.PresharedKey.ValueString(),
+		TunnelSource:  data.TunnelSource.ValueString(),
+		WANNextHop:    data.WANNextHop.ValueString(),
+		RoutingType:   routing.GetType(),
+		LocalNetworks: []string{"0.0.0.0/0"},
+	}
+	if routing.Data.StaticDataResponseObj != nil && len(routing.Data.StaticDataResponseObj.NetworkCIDRs) > 0 {
+		config.LocalNetworks = routing.Data.StaticDataResponseObj.NetworkCIDRs
+	}
+
+	for _, hub := range group.Hubs {
+		datacenter := hub.GetDatacenter()
+		datacenterKey := strings.ToLower(strings.Trim(ntgConfigKeyPattern.ReplaceAllString(datacenter.GetName(), "-"), "-"))
+		config.Hubs = append(config.Hubs, ntgDeviceConfigHub{
+			ID:         hub.GetId(),
+			Key:        fmt.Sprintf("%s-%d", datacenterKey, hub.GetId()),
+			Datacenter: datacenter.GetName(),
+			IP:         datacenter.GetIp(),
+			AuthID:     hub.GetAuthId(),
+			IsPrimary:  hub.GetIsPrimary(),
+		})
+	}
+	sort.SliceStable(config.Hubs, func(i, j int) bool {
+		return config.Hubs[i].IsPrimary && !config.Hubs[j].IsPrimary
+	})
+	return config
+}
+
+// renderNTGDeviceConfig renders the configuration of platform
+func renderNTGDeviceConfig(platform string, config ntgDeviceConfig) (string, error) {
+	if len(config.Hubs) == 0 {
+		return "", fmt.Errorf("network tunnel group %q has no hubs", config.Name)
+	}
+	// BGP routing needs peering with the hubs, which the templates do not
+	// configure; without it no traffic would reach the tunnels
+	if config.RoutingType == ntgRoutingBGP {
+		return "", fmt.Errorf("network tunnel group %q uses bgp routing, which requires BGP peering with the hubs that is not rendered; "+
+			"configure the device manually or use static or nat routing", config.Name)
+	}
+	if config.TunnelSource == "" {
+		config.TunnelSource = ntgDefaultTunnelSources[platform]
+	}
+
+	switch platform {
+	case ntgPlatformMerakiMX:
+		return renderNTGMerakiPeers(config)
+	case ntgPlatformIOS, ntgPlatformStrongSwan:
+		var rendered strings.Builder
+		if err := ntgConfigTemplates.ExecuteTemplate(&rendered, platform, config); err != nil {
+			return "", err
+		}
+		return rendered.String(), nil
+	default:
+		return "", fmt.Errorf("unsupported platform %q", platform)
+	}
+}
+
+// merakiThirdPartyVPNPeer is a peer of the Meraki Dashboard API
+// organization third-party VPN peers
+type merakiThirdPartyVPNPeer struct {
+	Name                string   `json:"name"`
+	PublicIP            string   `json:"publicIp"`
+	PrivateSubnets      []string `json:"privateSubnets"`
+	Secret              string   `json:"secret"`
+	IkeVersion          string   `json:"ikeVersion"`
+	LocalID             string   `json:"localId"`
+	RemoteID            string   `json:"remoteId"`
+	IpsecPoliciesPreset string   `json:"ipsecPoliciesPreset"`
+	NetworkTags         []string `json:"networkTags"`
+}
+
+// renderNTGMerakiPeers renders the peers of the Meraki Dashboard API
+// organization third-party VPN peers update, one per hub
+func renderNTGMerakiPeers(config ntgDeviceConfig) (string, error) {
+	peers := make([]merakiThirdPartyVPNPeer, 0, len(config.Hubs))
+	for _, hub := range config.Hubs {
+		peers = append(peers, merakiThirdPartyVPNPeer{
+			Name:                fmt.Sprintf("%s %s", config.Name, hub.Datacenter),
+			PublicIP:            hub.IP,
+			PrivateSubnets:      []string{"0.0.0.0/0"},
+			Secret:              config.PresharedKey,
+			IkeVersion:          "2",
+			LocalID:             hub.AuthID,
+			RemoteID:            hub.IP,
+			IpsecPoliciesPreset: "default",
+			NetworkTags:         []string{"all"},
+		})
+	}
+
+	return renderNTGJSON(map[string]any{"peers": peers})
+}
+
+// renderNTGJSON renders value as indented JSON
+func renderNTGJSON(value any) (string, error) {
+	rendered, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(rendered) + "\n", nil
+}
+
+// ntgRouteDistance returns the administrative distance of the routes over
+// the tunnel to the hub at index, so that the tunnels of secondary hubs only
+// carry traffic while the primary tunnel is down
+func ntgRouteDistance(index int) int {
+	if index == 0 {
+		return 1
+	}
+	return 10 * index
+}
+
+// ntgNetmask formats a CIDR as the address and netmask IOS routes take
+func ntgNetmask(cidr string) string {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return cidr
+	}
+	return network.IP.String() + " " + net.IP(network.Mask).String()
+}
+
+// ntgConfigTemplates render the text configurations. Each hub gets its own
+// IKEv2 profile, since its authentication ID is the local identity.
+var ntgConfigTemplates = template.Must(template.New("").Funcs(template.FuncMap{
+	"add":      func(a, b int) int { return a + b },
+	"join":     func(values []string) string { return strings.Join(values, ",") },
+	"distance": ntgRouteDistance,
+	"netmask":  ntgNetmask,
+}).Parse(`
+{{- define "ios" -}}
+! Secure Access network tunnel group {{ .Name }}
+crypto ikev2 proposal sse-proposal
+ encryption aes-gcm-256
+ prf sha256
+ group 19 20
+!
+crypto ikev2 policy sse-policy
+ proposal sse-proposal
+!
+crypto ikev2 keyring sse-keyring
+{{- range .Hubs }}
+ peer {{ .Key }}
+  address {{ .IP }}
+  pre-shared-key {{ $.PresharedKey }}
+{{- end }}
+!
+{{- range .Hubs }}
+crypto ikev2 profile {{ .Key }}
+ match identity remote address {{ .IP }} 255.255.255.255
+ identity local email {{ .AuthID }}
+ authentication remote pre-share
+ authentication local pre-share
+ keyring local sse-keyring
+ dpd 10 2 periodic
+!
+{{- end }}
+crypto ipsec transform-set sse-transform esp-gcm 256
+ mode tunnel
+!
+{{- range .Hubs }}
+crypto ipsec profile {{ .Key }}
+ set transform-set sse-transform
+ set ikev2-profile {{ .Key }}
+!
+{{- end }}
+{{- range $i, $hub := .Hubs }}
+interface Tunnel{{ add 100 $i }}
+ description Secure Access {{ if $hub.IsPrimary }}primary{{ else }}secondary{{ end }} hub {{ $hub.Datacenter }}
+ ip unnumbered {{ $.TunnelSource }}
+ ip mtu 1400
+ ip tcp adjust-mss 1350
+ tunnel source {{ $.TunnelSource }}
+ tunnel mode ipsec ipv4
+ tunnel destination {{ $hub.IP }}
+ tunnel protection ipsec profile {{ $hub.Key }}
+!
+{{- end }}
+{{- if .TunnelRoutes }}
+! Keep the hub addresses on the WAN and route the tunnel routes over the
+! tunnels, through the secondary hubs while the primary tunnel is down
+{{- range .Hubs }}
+ip route {{ .IP }} 255.255.255.255 {{ if $.WANNextHop }}{{ $.WANNextHop }}{{ else }}{{ $.TunnelSource }} dhcp{{ end }}
+{{- end }}
+{{- range $i, $hub := .Hubs }}{{ range $.TunnelRoutes }}
+ip route {{ netmask . }} Tunnel{{ add 100 $i }}{{ if $i }} {{ distance $i }}{{ end }}
+{{- end }}{{ end }}
+!
+{{- end }}
+{{ end -}}
+
+{{- define "strongswan" -}}
+# Secure Access network tunnel group {{ .Name }}
+connections {
+{{- range .Hubs }}
+  {{ .Key }} {
+    version = 2
+    local_addrs = {{ $.TunnelSource }}
+    remote_addrs = {{ .IP }}
+    proposals = aes256gcm16-prfsha256-ecp256
+    dpd_delay = 10s
+    local {
+      auth = psk
+      id = {{ .AuthID }}
+    }
+    remote {
+      auth = psk
+      id = {{ .IP }}
+    }
+    children {
+      {{ .Key }} {
+        local_ts = {{ join $.LocalNetworks }}
+        remote_ts = 0.0.0.0/0
+        esp_proposals = aes256gcm16
+        dpd_action = restart
+{{- if .IsPrimary }}
+        start_action = start
+{{- else }}
+        # Secondary hub, start it when the primary hub is unavailable
+        start_action = none
+{{- end }}
+      }
+    }
+  }
+{{- end }}
+}
+
+secrets {
+{{- range .Hubs }}
+  ike-{{ .Key }} {
+    id-local = {{ .AuthID }}
+    id-remote = {{ .IP }}
+    secret = "{{ $.PresharedKey }}"
+  }
+{{- end }}
+}
+{{ end -}}
+`))
@@ -0,0 +1,206 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/ntg"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+const testNTGConfigDataSourceName = "data.ciscosecureaccess_network_tunnel_group_config.test"
+
+func TestNetworkTunnelGroupConfigDataSource_strongswan(t *testing.T) {
+	rateLimitedTest(t, func() {
+		testName := generateNTGTestName("config")
+		identifierPrefix := generateNTGIdentifierPrefix("config")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccNTGBasicConfig(testName, identifierPrefix) + `
+data "ciscosecureaccess_network_tunnel_group_config" "test" {
+  id            = ciscosecureaccess_network_tunnel_group.test_resource.id
+  preshared_key = "` + testNTGPresharedKey + `"
+  platform      = "strongswan"
+}
+`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPair(testNTGConfigDataSourceName, "id", testNTGResourceName, "id"),
+						resource.TestCheckResourceAttrWith(testNTGConfigDataSourceName, "config", func(config string) error {
+							for _, want := range []string{"local_ts = " + testNTGNetworkCIDR, "secret = \"" + testNTGPresharedKey + "\"", "id = " + identifierPrefix + "@"} {
+								if !strings.Contains(config, want) {
+									return fmt.Errorf("config does not contain %q:\n%s", want, config)
+								}
+							}
+							return nil
+						}),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(testNTGConfigDataSourceName, tfjsonpath.New("platform"), knownvalue.StringExact(ntgPlatformStrongSwan)),
+					},
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+func TestNetworkTunnelGroupConfigDataSource_missingPlatform(t *testing.T) {
+	rateLimitedTest(t, func() {
+		testName := generateNTGTestName("config")
+		identifierPrefix := generateNTGIdentifierPrefix("config")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					// Tunnel groups of "other" devices have no default platform
+					Config: testAccNTGBasicConfig(testName, identifierPrefix) + `
+data "ciscosecureaccess_network_tunnel_group_config" "test" {
+  id            = ciscosecureaccess_network_tunnel_group.test_resource.id
+  preshared_key = "` + testNTGPresharedKey + `"
+}
+`,
+					ExpectError: regexp.MustCompile(`Missing Platform`),
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+// testNTGConfigGroup returns a tunnel group response whose secondary hub is
+// listed first
+func testNTGConfigGroup(t *testing.T, routing string) *ntg.NetworkTunnelGroupResponse {
+	t.Helper()
+	body := fmt.Sprintf(`{
+		"id": 7, "name": "Branch 1", "deviceType": "ISR", "region": "us-test-2",
+		"hubs": [
+			{"id": 12, "isPrimary": false, "authId": "branch@1-71-sse.cisco.com", "datacenter": {"name": "sse-us-test-2 (2)", "ip": "203.0.113.2"}},
+			{"id": 11, "isPrimary": true, "authId": "branch@1-70-sse.cisco.com", "datacenter": {"name": "sse-us-test-2 (1)", "ip": "203.0.113.1"}}
+		],
+		"routing": %s
+	}`, routing)
+	var group ntg.NetworkTunnelGroupResponse
+	if err := json.Unmarshal([]byte(body), &group); err != nil {
+		t.Fatalf("decoding tunnel group: %v", err)
+	}
+	return &group
+}
+
+func TestNewNTGDeviceConfig(t *testing.T) {
+	data := ntgConfigDataSourceModel{PresharedKey: types.StringValue(testNTGPresharedKey), TunnelSource: types.StringNull()}
+
+	config := newNTGDeviceConfig(testNTGConfigGroup(t, `{"type": "static", "data": {"networkCIDRs": ["10.10.110.0/24", "10.10.111.0/24"]}}`), data)
+	if len(config.Hubs) != 2 || !config.Hubs[0].IsPrimary || config.Hubs[0].Key != "sse-us-test-2-1-11" || config.Hubs[1].IP != "203.0.113.2" {
+		t.Errorf("hubs = %+v, want primary hub first", config.Hubs)
+	}
+	if strings.Join(config.LocalNetworks, ",") != "10.10.110.0/24,10.10.111.0/24" {
+		t.Errorf("static local networks = %v", config.LocalNetworks)
+	}
+
+	config = newNTGDeviceConfig(testNTGConfigGroup(t, `{"type": "bgp", "data": {"asNumber": "65010"}}`), data)
+	if strings.Join(config.LocalNetworks, ",") != "0.0.0.0/0" {
+		t.Errorf("bgp local networks = %v, want 0.0.0.0/0", config.LocalNetworks)
+	}
+
+	// Hubs in the same datacenter still get their own peers and profiles
+	group := testNTGConfigGroup(t, `{"type": "bgp", "data": {"asNumber": "65010"}}`)
+	group.Hubs[0].Datacenter.SetName("sse-us-test-2 (1)")
+	config = newNTGDeviceConfig(group, data)
+	if config.Hubs[0].Key == config.Hubs[1].Key {
+		t.Errorf("hubs of one datacenter share the key %q", config.Hubs[0].Key)
+	}
+}
+
+func TestRenderNTGDeviceConfig(t *testing.T) {
+	data := ntgConfigDataSourceModel{PresharedKey: types.StringValue(testNTGPresharedKey), TunnelSource: types.StringNull(), WANNextHop: types.StringNull()}
+	config := newNTGDeviceConfig(testNTGConfigGroup(t, `{"type": "static", "data": {"networkCIDRs": ["10.10.110.0/24"]}}`), data)
+	routed := config
+	routed.TunnelRoutes = []string{"10.0.0.0/8", "172.16.0.0/12"}
+
+	cases := []struct {
+		platform string
+		config   ntgDeviceConfig
+		want     []string
+	}{
+		{ntgPlatformIOS, routed, []string{
+			"crypto ikev2 profile sse-us-test-2-1-11\n match identity remote address 203.0.113.1 255.255.255.255\n identity local email branch@1-70-sse.cisco.com\n",
+			"  address 203.0.113.2\n  pre-shared-key " + testNTGPresharedKey + "\n",
+			"interface Tunnel100\n description Secure Access primary hub sse-us-test-2 (1)\n",
+			" tunnel source GigabitEthernet1\n tunnel mode ipsec ipv4\n tunnel destination 203.0.113.2\n tunnel protection ipsec profile sse-us-test-2-2-12\n",
+			"ip route 203.0.113.2 255.255.255.255 GigabitEthernet1 dhcp\n",
+			"ip route 10.0.0.0 255.0.0.0 Tunnel100\nip route 172.16.0.0 255.240.0.0 Tunnel100\n",
+			"ip route 10.0.0.0 255.0.0.0 Tunnel101 10\nip route 172.16.0.0 255.240.0.0 Tunnel101 10\n",
+		}},
+		{ntgPlatformStrongSwan, config, []string{
+			"    local_addrs = %any\n    remote_addrs = 203.0.113.1\n",
+			"      id = branch@1-71-sse.cisco.com\n",
+			"        local_ts = 10.10.110.0/24\n",
+			"        start_action = none\n",
+			"    secret = \"" + testNTGPresharedKey + "\"\n",
+		}},
+		{ntgPlatformMerakiMX, config, []string{
+			`"publicIp": "203.0.113.1"`,
+			`"localId": "branch@1-71-sse.cisco.com"`,
+			`"secret": "` + testNTGPresharedKey + `"`,
+			`"ikeVersion": "2"`,
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.platform, func(t *testing.T) {
+			rendered, err := renderNTGDeviceConfig(tc.platform, tc.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(rendered, want) {
+					t.Errorf("%s configuration does not contain %q:\n%s", tc.platform, want, rendered)
+				}
+			}
+		})
+	}
+
+	// Routes are only rendered when requested
+	if rendered, _ := renderNTGDeviceConfig(ntgPlatformIOS, config); strings.Contains(rendered, "ip route") {
+		t.Errorf("routes rendered without tunnel_routes:\n%s", rendered)
+	}
+
+	custom := routed
+	custom.TunnelSource = "Dialer1"
+	custom.WANNextHop = "198.51.100.1"
+	rendered, _ := renderNTGDeviceConfig(ntgPlatformIOS, custom)
+	if !strings.Contains(rendered, " tunnel source Dialer1\n") {
+		t.Errorf("tunnel_source not rendered:\n%s", rendered)
+	}
+	if !strings.Contains(rendered, "ip route 203.0.113.1 255.255.255.255 198.51.100.1\n") {
+		t.Errorf("wan_next_hop not rendered:\n%s", rendered)
+	}
+
+	// The hubs would never learn the routes of BGP groups
+	bgp := newNTGDeviceConfig(testNTGConfigGroup(t, `{"type": "bgp", "data": {"asNumber": "65010"}}`), data)
+	for _, platform := range ntgPlatforms {
+		if _, err := renderNTGDeviceConfig(platform, bgp); err == nil || !strings.Contains(err.Error(), "bgp routing") {
+			t.Errorf("rendering %s for a bgp group: error %v, want bgp routing error", platform, err)
+		}
+	}
+
+	if _, err := renderNTGDeviceConfig(ntgPlatformIOS, ntgDeviceConfig{Name: "empty"}); err == nil {
+		t.Error("rendering a tunnel group without hubs succeeded")
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/CiscoDevNet/go-ciscosecureaccess/ntg"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &networkTunnelGroupStateDataSource{}
+	_ datasource.DataSourceWithConfigure = &networkTunnelGroupStateDataSource{}
+)
+
+// NewNetworkTunnelGroupStateDataSource creates the data source implementation.
+func NewNetworkTunnelGroupStateDataSource() datasource.DataSource {
+	return &networkTunnelGroupStateDataSource{}
+}
+
+type networkTunnelGroupStateDataSource struct {
+	client ntg.APIClient
+}
+
+// ntgStateDataSourceModel maps the data source schema data.
+type ntgStateDataSourceModel struct {
+	Id     types.Int64  `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Status types.String `tfsdk:"status"`
+	Hubs   types.List   `tfsdk:"hubs"`
+}
+
+// ntgHubStateModel maps the state of a single hub.
+type ntgHubStateModel struct {
+	Id              types.Int64  `tfsdk:"id"`
+	IsPrimary       types.Bool   `tfsdk:"is_primary"`
+	Datacenter      types.String `tfsdk:"datacenter"`
+	Status          types.String `tfsdk:"status"`
+	StatusChangedAt types.String `tfsdk:"status_changed_at"`
+	Tunnels         types.List   `tfsdk:"tunnels"`
+}
+
+func (h ntgHubStateModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                types.Int64Type,
+		"is_primary":        types.BoolType,
+		"datacenter":        types.StringType,
+		"status":            types.StringType,
+		"status_changed_at": types.StringType,
+		"tunnels":           types.ListType{ElemType: types.ObjectType{AttrTypes: ntgTunnelStateModel{}.AttrTypes()}},
+	}
+}
+
+// ntgTunnelStateModel maps the state of a single tunnel to a hub.
+type ntgTunnelStateModel struct {
+	Status          types.String `tfsdk:"status"`
+	StatusChangedAt types.String `tfsdk:"status_changed_at"`
+	IkeState        types.String `tfsdk:"ike_state"`
+	IpsecState      types.String `tfsdk:"ipsec_state"`
+	PeerId          types.String `tfsdk:"peer_id"`
+	PeerIp          types.String `tfsdk:"peer_ip"`
+	LocalIp         types.String `tfsdk:"local_ip"`
+}
+
+func (t ntgTunnelStateModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"status":            types.StringType,
+		"status_changed_at": types.StringType,
+		"ike_state":         types.StringType,
+		"ipsec_state":       types.StringType,
+		"peer_id":           types.StringType,
+		"peer_ip":           types.StringType,
+		"local_ip":          types.StringType,
+	}
+}
+
+func (d *networkTunnelGroupStateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_tunnel_group_state"
+}
+
+func (d *networkTunnelGroupStateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	factory, ok := req.ProviderData.(*client.SSEClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data Type",
+			fmt.Sprintf("expected *client.SSEClientFactory, got %T", req.ProviderData))
+		return
+	}
+	d.client = *factory.GetNtgClient(ctx)
+}
+
+func (d *networkTunnelGroupStateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for retrieving the current status of a network tunnel group, its hubs and the IPsec tunnels " +
+			"connected to each hub. The API reports up to 10 tunnels per hub.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "Unique ID of network tunnel group",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of network tunnel group",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Overall status of network tunnel group: connected, disconnected or warning",
+				Computed:    true,
+			},
+			"hubs": schema.ListNestedAttribute{
+				Description: "Status of the hubs of network tunnel group",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Unique ID of hub",
+							Computed:    true,
+						},
+						"is_primary": schema.BoolAttribute{
+							Description: "Whether or not hub is designated as 'primary'",
+							Computed:    true,
+						},
+						"datacenter": schema.StringAttribute{
+							Description: "Name of datacenter where hub is located",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of hub: UP, DOWN or UNKNOWN",
+							Computed:    true,
+						},
+						"status_changed_at": schema.StringAttribute{
+							Description: "RFC3339 timestamp of when the status of hub was last reported",
+							Computed:    true,
+						},
+						"tunnels": schema.ListNestedAttribute{
+							Description: "Status of the tunnels connected to hub",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"status": schema.StringAttribute{
+										Description: "Status of tunnel: UP, DOWN, FAILED or UNKNOWN",
+										Computed:    true,
+									},
+									"status_changed_at": schema.StringAttribute{
+										Description: "RFC3339 timestamp of when the status of tunnel was last reported",
+										Computed:    true,
+									},
+									"ike_state": schema.StringAttribute{
+										Description: "State of the IKE (phase 1) security association, for example ESTABLISHED",
+										Computed:    true,
+									},
+									"ipsec_state": schema.StringAttribute{
+										Description: "State of the IPsec (phase 2) security association, for example INSTALLED",
+										Computed:    true,
+									},
+									"peer_id": schema.StringAttribute{
+										Description: "IKE ID of the device at the remote end of tunnel",
+										Computed:    true,
+									},
+									"peer_ip": schema.StringAttribute{
+										Description: "IP address of the device at the remote end of tunnel",
+										Computed:    true,
+									},
+									"local_ip": schema.StringAttribute{
+										Description: "Public IP address assigned to the device",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *networkTunnelGroupStateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ntgStateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunnelId := data.Id.ValueInt64()
+	tflog.Debug(ctx, "Reading network tunnel group state", map[string]interface{}{"id": tunnelId})
+
+	groupState, _, err := d.client.NetworkTunnelGroupsStateAPI.GetNetworkTunnelGroupState(ctx, tunnelId).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading network tunnel group state",
+			fmt.Sprintf("Could not read state of network tunnel group ID %d: %s", tunnelId, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setNTGStateData(ctx, groupState, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setNTGStateData copies the state reported by the API to data. Timestamps
+// and fields the API leaves out are null.
+func setNTGStateData(ctx context.Context, groupState *ntg.NetworkTunnelGroupStateResponse, data *ntgStateDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Name = types.StringPointerValue(groupState.Name)
+	data.Status = types.StringNull()
+	if groupState.Status != nil {
+		data.Status = types.StringValue(string(*groupState.Status))
+	}
+
+	hubs := make([]ntgHubStateModel, 0, len(groupState.Hubs))
+	for _, hub := range groupState.Hubs {
+		hubModel := ntgHubStateModel{
+			Id:              types.Int64PointerValue(hub.Id),
+			IsPrimary:       types.BoolPointerValue(hub.IsPrimary),
+			Datacenter:      types.StringNull(),
+			Status:          types.StringNull(),
+			StatusChangedAt: types.StringNull(),
+		}
+		if hub.Datacenter != nil {
+			hubModel.Datacenter = types.StringPointerValue(hub.Datacenter.Name)
+		}
+		if hub.Status != nil {
+			hubModel.Status = types.StringValue(hub.Status.Status)
+			hubModel.StatusChangedAt = ntgStateTime(&hub.Status.Time)
+		}
+
+		tunnels := make([]ntgTunnelStateModel, 0, len(hub.TunnelsStatus))
+		for _, tunnel := range hub.TunnelsStatus {
+			tunnels = append(tunnels, ntgTunnelStateModel{
+				Status:          types.StringPointerValue(tunnel.Status),
+				StatusChangedAt: ntgStateTime(tunnel.Time),
+				IkeState:        types.StringPointerValue(tunnel.IkeState),
+				IpsecState:      types.StringPointerValue(tunnel.IpsecState),
+				PeerId:          types.StringPointerValue(tunnel.PeerId),
+				PeerIp:          types.StringPointerValue(tunnel.PeerIp),
+				LocalIp:         types.StringPointerValue(tunnel.LocalIp),
+			})
+		}
+
+		var tunnelDiags diag.Diagnostics
+		hubModel.Tunnels, tunnelDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ntgTunnelStateModel{}.AttrTypes()}, tunnels)
+		diags.Append(tunnelDiags...)
+		hubs = append(hubs, hubModel)
+	}
+
+	var hubDiags diag.Diagnostics
+	data.Hubs, hubDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ntgHubStateModel{}.AttrTypes()}, hubs)
+	diags.Append(hubDiags...)
+	return diags
+}
+
+// ntgStateTime formats a state timestamp as RFC3339, or null when the API
+// did not report one
+func ntgStateTime(value *time.Time) types.String {
+	if value == nil || value.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(value.Format(time.RFC3339))
+}
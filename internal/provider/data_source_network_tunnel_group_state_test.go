@@ -0,0 +1,130 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/ntg"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+const testNTGStateDataSourceName = "data.ciscosecureaccess_network_tunnel_group_state.test"
+
+func TestNetworkTunnelGroupStateDataSource_connected(t *testing.T) {
+	tunnelGroupID := testAccFixture(t, "TEST_CISCOSECUREACCESS_CONNECTED_NTG_ID", seedConnectedTunnelGroup)
+	rateLimitedTest(t, func() {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccNTGStateDataSourceConfig(tunnelGroupID),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(testNTGStateDataSourceName, "id", tunnelGroupID),
+						resource.TestCheckResourceAttrSet(testNTGStateDataSourceName, "name"),
+						resource.TestCheckResourceAttr(testNTGStateDataSourceName, "status", "connected"),
+						resource.TestCheckResourceAttrSet(testNTGStateDataSourceName, "hubs.0.status_changed_at"),
+						resource.TestCheckResourceAttrSet(testNTGStateDataSourceName, "hubs.0.tunnels.0.peer_ip"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(testNTGStateDataSourceName, tfjsonpath.New("hubs").AtSliceIndex(0).AtMapKey("tunnels").AtSliceIndex(0).AtMapKey("status"),
+							knownvalue.StringExact("UP")),
+						statecheck.ExpectKnownValue(testNTGStateDataSourceName, tfjsonpath.New("hubs").AtSliceIndex(0).AtMapKey("tunnels").AtSliceIndex(0).AtMapKey("ike_state"),
+							knownvalue.StringExact("ESTABLISHED")),
+					},
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+// seedConnectedTunnelGroup seeds a tunnel group with one established tunnel
+// for the fake API
+func seedConnectedTunnelGroup(f *fakeAPIServer) string {
+	tunnelGroupID := f.SeedConnectedTunnelGroup(generateNTGTestName("state"), "198.51.100.10")
+	return strconv.FormatInt(tunnelGroupID, 10)
+}
+
+func testAccNTGStateDataSourceConfig(tunnelGroupID string) string {
+	return fmt.Sprintf(`
+data "ciscosecureaccess_network_tunnel_group_state" "test" {
+  id = %s
+}
+`, tunnelGroupID)
+}
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+func TestSetNTGStateData(t *testing.T) {
+	var groupState ntg.NetworkTunnelGroupStateResponse
+	body := `{
+		"id": 7, "name": "branch", "organizationId": 1, "status": "warning",
+		"hubs": [
+			{
+				"id": 11, "isPrimary": true, "datacenter": {"name": "us-test-2-1"},
+				"status": {"status": "UP", "time": "2025-06-01T10:00:00.000Z"},
+				"tunnelsStatus": [
+					{"time": "2025-06-01T09:59:00.000Z", "status": "UP", "ikeState": "ESTABLISHED", "ipsecState": "INSTALLED",
+					 "peerId": "branch@1-70-sse.cisco.com", "peerIp": "198.51.100.10", "localIp": "198.51.100.10"},
+					{"status": "FAILED", "ikeState": "CONNECTING", "peerIp": "198.51.100.11"}
+				]
+			},
+			{"id": 12, "isPrimary": false, "datacenter": {"name": "us-test-2-2"}}
+		]
+	}`
+	if err := json.Unmarshal([]byte(body), &groupState); err != nil {
+		t.Fatalf("decoding state: %v", err)
+	}
+
+	var data ntgStateDataSourceModel
+	if diags := setNTGStateData(context.Background(), &groupState, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Name.ValueString() != "branch" || data.Status.ValueString() != "warning" {
+		t.Errorf("name %s, status %s", data.Name, data.Status)
+	}
+
+	var hubs []ntgHubStateModel
+	data.Hubs.ElementsAs(context.Background(), &hubs, false)
+	if len(hubs) != 2 {
+		t.Fatalf("got %d hubs, want 2", len(hubs))
+	}
+	if hubs[0].Datacenter.ValueString() != "us-test-2-1" || hubs[0].StatusChangedAt.ValueString() != "2025-06-01T10:00:00Z" {
+		t.Errorf("primary hub = %+v", hubs[0])
+	}
+	if !hubs[1].Status.IsNull() || !hubs[1].StatusChangedAt.IsNull() || len(hubs[1].Tunnels.Elements()) != 0 {
+		t.Errorf("hub without status = %+v", hubs[1])
+	}
+
+	var tunnels []ntgTunnelStateModel
+	hubs[0].Tunnels.ElementsAs(context.Background(), &tunnels, false)
+	if len(tunnels) != 2 {
+		t.Fatalf("got %d tunnels, want 2", len(tunnels))
+	}
+	want := ntgTunnelStateModel{
+		Status:          types.StringValue("UP"),
+		StatusChangedAt: types.StringValue("2025-06-01T09:59:00Z"),
+		IkeState:        types.StringValue("ESTABLISHED"),
+		IpsecState:      types.StringValue("INSTALLED"),
+		PeerId:          types.StringValue("branch@1-70-sse.cisco.com"),
+		PeerIp:          types.StringValue("198.51.100.10"),
+		LocalIp:         types.StringValue("198.51.100.10"),
+	}
+	if tunnels[0] != want {
+		t.Errorf("established tunnel = %+v, want %+v", tunnels[0], want)
+	}
+	if tunnels[1].Status.ValueString() != "FAILED" || !tunnels[1].StatusChangedAt.IsNull() || !tunnels[1].IpsecState.IsNull() {
+		t.Errorf("failed tunnel = %+v", tunnels[1])
+	}
+}
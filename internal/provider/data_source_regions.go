@@ -0,0 +1,258 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/CiscoDevNet/go-ciscosecureaccess/ntg"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Values of the status filter of the regions API
+const (
+	ntgRegionsAvailable = "available"
+	ntgRegionsAll       = "all"
+)
+
+var (
+	_ datasource.DataSource              = &regionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &regionsDataSource{}
+)
+
+// NewRegionsDataSource creates the data source implementation.
+func NewRegionsDataSource() datasource.DataSource {
+	return &regionsDataSource{}
+}
+
+type regionsDataSource struct {
+	client ntg.APIClient
+}
+
+// regionsDataSourceModel maps the data source schema data.
+type regionsDataSourceModel struct {
+	Status  types.String `tfsdk:"status"`
+	PeerIP  types.String `tfsdk:"peer_ip"`
+	Regions types.List   `tfsdk:"regions"`
+	Bgp     types.Object `tfsdk:"bgp"`
+}
+
+// regionModel maps a single network tunnel group region.
+type regionModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Continent   types.String `tfsdk:"continent"`
+}
+
+func (m regionModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"continent":   types.StringType,
+	}
+}
+
+// regionBgpModel maps the BGP peering settings of Secure Access.
+type regionBgpModel struct {
+	AsNumber  types.String `tfsdk:"as_number"`
+	PeerIPs   types.List   `tfsdk:"peer_ips"`
+	PeerRange types.String `tfsdk:"peer_range"`
+}
+
+func (m regionBgpModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"as_number":  types.StringType,
+		"peer_ips":   types.ListType{ElemType: types.StringType},
+		"peer_range": types.StringType,
+	}
+}
+
+func (d *regionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *regionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	factory, ok := req.ProviderData.(*client.SSEClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data Type",
+			fmt.Sprintf("expected *client.SSEClientFactory, got %T", req.ProviderData))
+		return
+	}
+	d.client = *factory.GetNtgClient(ctx)
+}
+
+func (d *regionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for retrieving the regions network tunnel groups can be deployed to, and the BGP peering settings of Secure Access",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Description: "available (default) lists the regions that accept new network tunnel groups, all lists every region",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ntgRegionsAvailable, ntgRegionsAll),
+				},
+			},
+			"peer_ip": schema.StringAttribute{
+				Description: "Public IP address of a device. If set, the regions are ordered by distance from the device, nearest first.",
+				Optional:    true,
+			},
+			"regions": schema.ListNestedAttribute{
+				Description: "List of regions",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of region, for use as the region of network tunnel groups",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of region",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Country and location of region",
+							Computed:    true,
+						},
+						"continent": schema.StringAttribute{
+							Description: "Continent where region is located",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"bgp": schema.SingleNestedAttribute{
+				Description: "BGP peering settings of Secure Access for network tunnel groups with bgp routing",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"as_number": schema.StringAttribute{
+						Description: "AS number of Secure Access",
+						Computed:    true,
+					},
+					"peer_ips": schema.ListAttribute{
+						Description: "IP addresses of the BGP peers of Secure Access",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"peer_range": schema.StringAttribute{
+						Description: "Address range of the BGP peers of Secure Access",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *regionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data regionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := ntg.FiltersRegionsObject{}
+	if !data.Status.IsNull() {
+		filters.Status = data.Status.ValueStringPointer()
+	}
+	if !data.PeerIP.IsNull() {
+		filters.PeerIP = data.PeerIP.ValueStringPointer()
+	}
+
+	regionList, err := listNTGRegions(ctx, d.client, filters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing regions",
+			fmt.Sprintf("Could not list network tunnel group regions: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setRegionsData(ctx, regionList, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listNTGRegions lists the network tunnel group regions matching filters
+func listNTGRegions(ctx context.Context, apiClient ntg.APIClient, filters ntg.FiltersRegionsObject) (*ntg.RegionList, error) {
+	regionList, _, err := apiClient.NetworkTunnelGroupsRegionsAPI.ListNetworkTunnelGroupRegions(ctx).Filters(filters).Execute()
+	if err != nil {
+		return nil, err
+	}
+	tflog.Debug(ctx, "Retrieved network tunnel group regions", map[string]interface{}{"count": len(regionList.Regions)})
+	return regionList, nil
+}
+
+// setRegionsData copies the regions in the order of the API, which sorts
+// them by distance when peer_ip is set
+func setRegionsData(ctx context.Context, regionList *ntg.RegionList, data *regionsDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	regions := make([]regionModel, 0, len(regionList.Regions))
+	for _, region := range regionList.Regions {
+		regions = append(regions, regionModel{
+			Id:          types.StringValue(region.Region),
+			Name:        types.StringValue(region.Name),
+			Description: types.StringValue(region.Description),
+			Continent:   types.StringValue(region.Continent),
+		})
+	}
+	var regionDiags diag.Diagnostics
+	data.Regions, regionDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: regionModel{}.AttrTypes()}, regions)
+	diags.Append(regionDiags...)
+
+	data.Bgp = types.ObjectNull(regionBgpModel{}.AttrTypes())
+	if bgp := regionList.Bgp; bgp != nil {
+		peerIPs, peerDiags := types.ListValueFrom(ctx, types.StringType, bgp.PeerIPs)
+		diags.Append(peerDiags...)
+		var bgpDiags diag.Diagnostics
+		data.Bgp, bgpDiags = types.ObjectValueFrom(ctx, regionBgpModel{}.AttrTypes(), regionBgpModel{
+			AsNumber:  types.StringPointerValue(bgp.AsNumber),
+			PeerIPs:   peerIPs,
+			PeerRange: types.StringPointerValue(bgp.PeerRange),
+		})
+		diags.Append(bgpDiags...)
+	}
+	return diags
+}
+
+// checkNTGRegion reports an error unless region is one of regions, the
+// regions that accept new network tunnel groups
+func checkNTGRegion(region string, regions []ntg.RegionListRegionsInner) diag.Diagnostics {
+	var diags diag.Diagnostics
+	ids := make([]string, 0, len(regions))
+	for _, candidate := range regions {
+		if candidate.Region == region {
+			return diags
+		}
+		ids = append(ids, candidate.Region)
+	}
+
+	diags.AddAttributeError(
+		path.Root("region"),
+		"Invalid Region",
+		fmt.Sprintf("Region %q does not exist or does not accept new network tunnel groups. It must be one of: %s. "+
+			"The ciscosecureaccess_regions data source lists the regions.", region, strings.Join(ids, ", ")),
+	)
+	return diags
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/ntg"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testRegionsDataSourceName = "data.ciscosecureaccess_regions.test"
+
+func TestRegionsDataSource_basic(t *testing.T) {
+	rateLimitedTest(t, func() {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `
+data "ciscosecureaccess_regions" "test" {}
+`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet(testRegionsDataSourceName, "regions.0.id"),
+						resource.TestCheckResourceAttrSet(testRegionsDataSourceName, "regions.0.name"),
+						resource.TestCheckResourceAttrSet(testRegionsDataSourceName, "bgp.as_number"),
+					),
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+func testRegionList(t *testing.T, body string) *ntg.RegionList {
+	t.Helper()
+	var regionList ntg.RegionList
+	if err := json.Unmarshal([]byte(body), &regionList); err != nil {
+		t.Fatalf("decoding regions: %v", err)
+	}
+	return &regionList
+}
+
+func TestSetRegionsData(t *testing.T) {
+	regionList := testRegionList(t, `{
+		"regions": [
+			{"name": "US Test 2", "region": "us-test-2", "description": "Test City, US", "continent": "North America"},
+			{"name": "EU Test 1", "region": "eu-test-1", "description": "Test City, DE", "continent": "Europe"}
+		],
+		"bgp": {"asNumber": "64512", "peerIPs": ["169.254.0.9", "169.254.0.13"], "peerRange": "169.254.0.0/24"}
+	}`)
+
+	var data regionsDataSourceModel
+	if diags := setRegionsData(context.Background(), regionList, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var regions []regionModel
+	data.Regions.ElementsAs(context.Background(), &regions, false)
+	if len(regions) != 2 || regions[0].Id.ValueString() != "us-test-2" || regions[1].Continent.ValueString() != "Europe" {
+		t.Errorf("regions = %+v, want API order", regions)
+	}
+
+	var bgp regionBgpModel
+	data.Bgp.As(context.Background(), &bgp, basetypes.ObjectAsOptions{})
+	if bgp.AsNumber.ValueString() != "64512" || len(bgp.PeerIPs.Elements()) != 2 || bgp.PeerRange.ValueString() != "169.254.0.0/24" {
+		t.Errorf("bgp = %+v", bgp)
+	}
+
+	if diags := setRegionsData(context.Background(), testRegionList(t, `{"regions": []}`), &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(data.Regions.Elements()) != 0 || !data.Bgp.IsNull() {
+		t.Errorf("empty region list gave regions %s, bgp %s", data.Regions, data.Bgp)
+	}
+}
+
+func TestCheckNTGRegion(t *testing.T) {
+	regions := testRegionList(t, `{"regions": [
+		{"name": "US Test 2", "region": "us-test-2", "description": "Test City, US", "continent": "North America"},
+		{"name": "EU Test 1", "region": "eu-test-1", "description": "Test City, DE", "continent": "Europe"}
+	]}`).Regions
+
+	if diags := checkNTGRegion("eu-test-1", regions); diags.HasError() {
+		t.Errorf("valid region: unexpected diagnostics %v", diags)
+	}
+
+	diags := checkNTGRegion("us-test-9", regions)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("invalid region: got %d errors, want 1", diags.ErrorsCount())
+	}
+	if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "us-test-2, eu-test-1") {
+		t.Errorf("detail %q does not list the regions", detail)
+	}
+	if summary := diags.Errors()[0].Summary(); summary != "Invalid Region" {
+		t.Errorf("summary = %q", summary)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Network tunnel group passphrases are 16 to 64 letters and digits with at
+// least one upper case letter, one lower case letter and one digit
+const (
+	presharedKeyMinLength     = 16
+	presharedKeyMaxLength     = 64
+	defaultPresharedKeyLength = 32
+	presharedKeyAlphabet      = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+var (
+	presharedKeyCharset = regexp.MustCompile(`^[A-Za-z0-9]*$`)
+	presharedKeyUpper   = regexp.MustCompile(`[A-Z]`)
+	presharedKeyLower   = regexp.MustCompile(`[a-z]`)
+	presharedKeyDigit   = regexp.MustCompile(`[0-9]`)
+	presharedKeyClasses = []*regexp.Regexp{presharedKeyUpper, presharedKeyLower, presharedKeyDigit}
+)
+
+// presharedKeyValidators enforce the network tunnel group passphrase rules
+// at plan time
+func presharedKeyValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(presharedKeyMinLength, presharedKeyMaxLength),
+		stringvalidator.RegexMatches(presharedKeyCharset, "must only contain letters and digits"),
+		stringvalidator.RegexMatches(presharedKeyUpper, "must contain an upper case letter"),
+		stringvalidator.RegexMatches(presharedKeyLower, "must contain a lower case letter"),
+		stringvalidator.RegexMatches(presharedKeyDigit, "must contain a digit"),
+	}
+}
+
+// generatePresharedKey returns a random passphrase of length characters that
+// satisfies the network tunnel group passphrase rules
+func generatePresharedKey(length int) (string, error) {
+	alphabetSize := big.NewInt(int64(len(presharedKeyAlphabet)))
+	key := make([]byte, length)
+	for {
+		for i := range key {
+			n, err := rand.Int(rand.Reader, alphabetSize)
+			if err != nil {
+				return "", err
+			}
+			key[i] = presharedKeyAlphabet[n.Int64()]
+		}
+		// Draw again until every character class is present, rather than
+		// placing characters and biasing their positions
+		compliant := true
+		for _, re := range presharedKeyClasses {
+			compliant = compliant && re.Match(key)
+		}
+		if compliant {
+			return string(key), nil
+		}
+	}
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ ephemeral.EphemeralResource = &ntgPresharedKeyEphemeralResource{}
+
+// NewNTGPresharedKeyEphemeralResource is a helper function to simplify the provider implementation.
+func NewNTGPresharedKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &ntgPresharedKeyEphemeralResource{}
+}
+
+// ntgPresharedKeyEphemeralResource generates network tunnel group
+// passphrases without ever writing them to plan or state
+type ntgPresharedKeyEphemeralResource struct{}
+
+// ntgPresharedKeyModel maps the ephemeral resource schema data.
+type ntgPresharedKeyModel struct {
+	Length types.Int64  `tfsdk:"length"`
+	Result types.String `tfsdk:"result"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *ntgPresharedKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_tunnel_group_preshared_key"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *ntgPresharedKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a random preshared key that meets the network tunnel group passphrase rules: " +
+			"16 to 64 letters and digits, with at least one upper case letter, one lower case letter and one digit.",
+		Attributes: map[string]schema.Attribute{
+			"length": schema.Int64Attribute{
+				Description: fmt.Sprintf("Number of characters in the preshared key, between %d and %d. Defaults to %d.", presharedKeyMinLength, presharedKeyMaxLength, defaultPresharedKeyLength),
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(presharedKeyMinLength, presharedKeyMaxLength),
+				},
+			},
+			"result": schema.StringAttribute{
+				Description: "Generated preshared key",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Open generates the preshared key.
+func (e *ntgPresharedKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ntgPresharedKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	length := int64(defaultPresharedKeyLength)
+	if !data.Length.IsNull() && !data.Length.IsUnknown() {
+		length = data.Length.ValueInt64()
+	}
+
+	key, err := generatePresharedKey(int(length))
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating preshared key", err.Error())
+		return
+	}
+	data.Length = types.Int64Value(length)
+	data.Result = types.StringValue(key)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
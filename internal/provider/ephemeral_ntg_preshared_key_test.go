@@ -0,0 +1,67 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGeneratePresharedKey(t *testing.T) {
+	seen := map[string]bool{}
+	for _, length := range []int{presharedKeyMinLength, defaultPresharedKeyLength, presharedKeyMaxLength} {
+		for range 20 {
+			key, err := generatePresharedKey(length)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(key) != length {
+				t.Errorf("key %q has %d characters, want %d", key, len(key), length)
+			}
+			if diags := validatePresharedKey(key); len(diags) > 0 {
+				t.Errorf("generated key %q is not compliant: %v", key, diags)
+			}
+			if seen[key] {
+				t.Errorf("key %q was generated twice", key)
+			}
+			seen[key] = true
+		}
+	}
+}
+
+func TestPresharedKeyValidators(t *testing.T) {
+	for key, valid := range map[string]bool{
+		testNTGPresharedKey:  true,
+		"Testing1Testing1":   true,
+		"Short1a":            false,
+		"alllowercase12345":  false,
+		"ALLUPPERCASE12345":  false,
+		"NoDigitsAtAllHere":  false,
+		"Has-Special-Char1a": false,
+	} {
+		if got := len(validatePresharedKey(key)) == 0; got != valid {
+			t.Errorf("preshared key %q valid = %t, want %t", key, got, valid)
+		}
+	}
+}
+
+// validatePresharedKey runs the preshared_key validators against key and
+// returns their error summaries
+func validatePresharedKey(key string) []string {
+	var errors []string
+	for _, v := range presharedKeyValidators() {
+		req := validator.StringRequest{Path: path.Root("preshared_key"), ConfigValue: types.StringValue(key)}
+		resp := &validator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+		for _, d := range resp.Diagnostics.Errors() {
+			errors = append(errors, d.Detail())
+		}
+	}
+	return errors
+}
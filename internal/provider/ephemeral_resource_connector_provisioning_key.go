@@ -0,0 +1,153 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/CiscoDevNet/go-ciscosecureaccess/resconn"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// connectorGroupPatchPathProvisioningKey replaces the provisioning key of a
+// connector group with a new one when patched with an empty value
+const connectorGroupPatchPathProvisioningKey = "/provisioningKey"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &provisioningKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &provisioningKeyEphemeralResource{}
+)
+
+// NewProvisioningKeyEphemeralResource is a helper function to simplify the provider implementation.
+func NewProvisioningKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &provisioningKeyEphemeralResource{}
+}
+
+// provisioningKeyEphemeralResource fetches the provisioning key of a resource
+// connector group without writing it to plan or state
+type provisioningKeyEphemeralResource struct {
+	client resconn.APIClient
+}
+
+// provisioningKeyModel maps the ephemeral resource schema data.
+type provisioningKeyModel struct {
+	GroupID         types.Int64  `tfsdk:"group_id"`
+	Rotate          types.Bool   `tfsdk:"rotate"`
+	ProvisioningKey types.String `tfsdk:"provisioning_key"`
+	KeyExpiresAt    types.String `tfsdk:"key_expires_at"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *provisioningKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_connector_provisioning_key"
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *provisioningKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	factory, ok := req.ProviderData.(*client.SSEClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data Type",
+			fmt.Sprintf("expected *client.SSEClientFactory, got %T", req.ProviderData))
+		return
+	}
+	e.client = *factory.GetResConnClient(ctx)
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *provisioningKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provisioning key of a resource connector group, fetched when Terraform needs it and never written to plan or state. " +
+			"Pass it to connector instances through a write-only argument, such as a secret store they read at boot, to register them with the group.",
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.Int64Attribute{
+				Description: "ID of the resource connector group",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rotate": schema.BoolAttribute{
+				Description: "Replace the provisioning key of the group with a new one before returning it. Connectors already registered " +
+					"keep working, but the previous key can no longer register new connectors. The key is rotated every time Terraform " +
+					"opens the ephemeral resource, which includes plans. Defaults to false.",
+				Optional: true,
+			},
+			"provisioning_key": schema.StringAttribute{
+				Description: "Provisioning key for adding resource connectors to the group",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"key_expires_at": schema.StringAttribute{
+				Description: "Time at which the provisioning key expires",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Open fetches, and when requested rotates, the provisioning key.
+func (e *provisioningKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data provisioningKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := getProvisioningKey(ctx, e.client, data.GroupID.ValueInt64(), data.Rotate.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Resource Connector Group provisioning key",
+			fmt.Sprintf("Could not read the provisioning key of resource connector group %d: %s", data.GroupID.ValueInt64(), err.Error()),
+		)
+		return
+	}
+
+	data.Rotate = types.BoolValue(data.Rotate.ValueBool())
+	data.ProvisioningKey = types.StringValue(group.GetProvisioningKey())
+	data.KeyExpiresAt = types.StringValue(group.GetProvisioningKeyExpiresAt().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// getProvisioningKey returns the connector group with its provisioning key,
+// replacing the key first when rotate is set
+func getProvisioningKey(ctx context.Context, apiClient resconn.APIClient, groupID int64, rotate bool) (*resconn.ConnectorGroupResponse, error) {
+	if rotate {
+		tflog.Info(ctx, "Rotating resource connector group provisioning key", map[string]interface{}{
+			"group_id": groupID,
+		})
+		patch := resconn.NewConnectorGroupPatchReqInner(resconn.REPLACE, connectorGroupPatchPathProvisioningKey, "")
+		_, _, err := apiClient.ConnectorGroupsAPI.PatchConnectorGroup(ctx, groupID).
+			ConnectorGroupPatchReqInner([]resconn.ConnectorGroupPatchReqInner{*patch}).
+			Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to rotate provisioning key: %w", err)
+		}
+	}
+
+	group, _, err := apiClient.ConnectorGroupsAPI.GetConnectorGroup(ctx, groupID).
+		IncludeProvisioningKey(true).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+	if group.GetProvisioningKey() == "" {
+		return nil, fmt.Errorf("the API returned no provisioning key for resource connector group %d", groupID)
+	}
+	return group, nil
+}
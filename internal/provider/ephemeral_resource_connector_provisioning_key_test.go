@@ -0,0 +1,57 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+)
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+func TestGetProvisioningKey_rotate(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeAPIServer()
+	defer fake.Close()
+
+	factory := &client.SSEClientFactory{
+		KeyId:         fakeAPIKeyID,
+		KeySecret:     fakeAPIKeySecret,
+		ApiEndpoint:   fake.Endpoint(),
+		SSEHttpClient: fake.HTTPClient(),
+	}
+	apiClient := *factory.GetResConnClient(ctx)
+	groupID := fake.SeedConnectorGroup("tf-test-provisioning-key")
+
+	first, err := getProvisioningKey(ctx, apiClient, groupID, false)
+	if err != nil {
+		t.Fatalf("getProvisioningKey: %v", err)
+	}
+	if first.GetProvisioningKey() == "" {
+		t.Fatal("expected a provisioning key")
+	}
+
+	again, err := getProvisioningKey(ctx, apiClient, groupID, false)
+	if err != nil {
+		t.Fatalf("getProvisioningKey: %v", err)
+	}
+	if again.GetProvisioningKey() != first.GetProvisioningKey() {
+		t.Error("provisioning key changed without rotate")
+	}
+
+	rotated, err := getProvisioningKey(ctx, apiClient, groupID, true)
+	if err != nil {
+		t.Fatalf("getProvisioningKey with rotate: %v", err)
+	}
+	if rotated.GetProvisioningKey() == "" || rotated.GetProvisioningKey() == first.GetProvisioningKey() {
+		t.Errorf("provisioning key was not rotated: %q", rotated.GetProvisioningKey())
+	}
+
+	if _, err := getProvisioningKey(ctx, apiClient, groupID+1000, false); err == nil {
+		t.Error("expected an error for an unknown connector group")
+	}
+}
@@ -0,0 +1,1743 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Credentials accepted by the fake API server's token endpoint.
+const (
+	fakeAPIKeyID     = "fake-key-id"
+	fakeAPIKeySecret = "fake-key-secret"
+	fakeAPIOrgID     = 8000001
+)
+
+// fakeAPIServer is an in-process, stateful stand-in for the Cisco Secure
+// Access API. Acceptance tests run against it whenever real API credentials
+// are not present in the environment.
+//
+// Objects are kept as decoded JSON documents so that whatever the provider
+// sends is returned verbatim on subsequent reads, the same way the real API
+// round-trips request bodies.
+type fakeAPIServer struct {
+	server *httptest.Server
+	mux    *http.ServeMux
+
+	mu     sync.Mutex
+	nextID int64
+	token  string
+
+	rules                 map[int64]map[string]any
+	policySettings        map[string]map[string]any
+	destinationLists      map[int64]map[string]any
+	destinations          map[int64][]map[string]any
+	privateResources      map[int64]map[string]any
+	privateResourceGroups map[int64]map[string]any
+	tunnelGroups          map[int64]map[string]any
+	sites                 map[int64]map[string]any
+	networks              map[int64]map[string]any
+	internalNetworks      map[int64]map[string]any
+	internalDomains       map[int64]map[string]any
+	roamingComputers      map[string]map[string]any
+	swgDeviceSettings     map[int64]map[string]any
+	connectors            map[int64]map[string]any
+	connectorGroups       map[int64]map[string]any
+	identities            []map[string]any
+	contentCategories     []map[string]any
+	childOrgs             []map[string]any
+}
+
+// newFakeAPIServer starts a fake API server listening on a loopback TLS port.
+// The caller is responsible for calling Close.
+func newFakeAPIServer() *fakeAPIServer {
+	f := &fakeAPIServer{
+		mux:                   http.NewServeMux(),
+		nextID:                1000,
+		rules:                 map[int64]map[string]any{},
+		policySettings:        map[string]map[string]any{},
+		destinationLists:      map[int64]map[string]any{},
+		destinations:          map[int64][]map[string]any{},
+		privateResources:      map[int64]map[string]any{},
+		privateResourceGroups: map[int64]map[string]any{},
+		tunnelGroups:          map[int64]map[string]any{},
+		sites:                 map[int64]map[string]any{},
+		networks:              map[int64]map[string]any{},
+		internalNetworks:      map[int64]map[string]any{},
+		internalDomains:       map[int64]map[string]any{},
+		roamingComputers:      map[string]map[string]any{},
+		swgDeviceSettings:     map[int64]map[string]any{},
+		connectors:            map[int64]map[string]any{},
+		connectorGroups:       map[int64]map[string]any{},
+	}
+	f.routes()
+	f.seed()
+	f.server = httptest.NewTLSServer(f)
+	return f
+}
+
+// Close shuts the server down.
+func (f *fakeAPIServer) Close() {
+	f.server.Close()
+}
+
+// Endpoint returns the host:port to use as the provider api_endpoint.
+func (f *fakeAPIServer) Endpoint() string {
+	return strings.TrimPrefix(f.server.URL, "https://")
+}
+
+// CACertPEM returns the PEM encoded certificate of the server, for use as
+// the CA bundle of the provider.
+func (f *fakeAPIServer) CACertPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: f.server.Certificate().Raw})
+}
+
+// HTTPClient returns the client the provider builds for the fake API: OAuth2
+// against the fake token endpoint, wrapped in the retry transport, over a
+// transport that trusts the server certificate.
+func (f *fakeAPIServer) HTTPClient() *http.Client {
+	return newAPIHTTPClient(apiClientConfig{
+		KeyID:       fakeAPIKeyID,
+		KeySecret:   fakeAPIKeySecret,
+		APIEndpoint: f.Endpoint(),
+		Transport:   f.server.Client().Transport,
+		Retry:       defaultRetryConfig(),
+	})
+}
+
+// ServeHTTP authenticates the request and dispatches it with the server lock
+// held, so handlers can mutate state freely.
+func (f *fakeAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// The reports client joins its base URL and paths with a double slash.
+	r.URL.Path = path.Clean(r.URL.Path)
+	r.URL.RawPath = ""
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path != "/auth/v2/token" {
+		if f.token == "" || r.Header.Get("Authorization") != "Bearer "+f.token {
+			fakeError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+	}
+	f.mux.ServeHTTP(w, r)
+}
+
+func (f *fakeAPIServer) routes() {
+	m := f.mux
+	m.HandleFunc("POST /auth/v2/token", f.issueToken)
+
+	// Policies
+	m.HandleFunc("GET /policies/v2/rules", f.listRules)
+	m.HandleFunc("POST /policies/v2/rules", f.addRule)
+	m.HandleFunc("GET /policies/v2/rules/{id}", f.getRule)
+	m.HandleFunc("PUT /policies/v2/rules/{id}", f.putRule)
+	m.HandleFunc("DELETE /policies/v2/rules/{id}", f.deleteRule)
+	m.HandleFunc("GET /policies/v2/settings", f.listPolicySettings)
+	m.HandleFunc("PUT /policies/v2/settings/{name}", f.putPolicySetting)
+	m.HandleFunc("GET /policies/v2/categorySettings", f.listContentCategories)
+
+	m.HandleFunc("GET /policies/v2/destinationlists", f.listDestinationLists)
+	m.HandleFunc("POST /policies/v2/destinationlists", f.createDestinationList)
+	m.HandleFunc("GET /policies/v2/destinationlists/{id}", f.getDestinationList)
+	m.HandleFunc("PATCH /policies/v2/destinationlists/{id}", f.patchDestinationList)
+	m.HandleFunc("DELETE /policies/v2/destinationlists/{id}", f.deleteDestinationList)
+	m.HandleFunc("GET /policies/v2/destinationlists/{id}/destinations", f.listDestinations)
+	m.HandleFunc("POST /policies/v2/destinationlists/{id}/destinations", f.addDestinations)
+	m.HandleFunc("DELETE /policies/v2/destinationlists/{id}/destinations/remove", f.removeDestinations)
+
+	m.HandleFunc("GET /policies/v2/privateResources", f.listPrivateResources)
+	m.HandleFunc("POST /policies/v2/privateResources", f.addPrivateResource)
+	m.HandleFunc("GET /policies/v2/privateResources/{id}", f.getPrivateResource)
+	m.HandleFunc("PUT /policies/v2/privateResources/{id}", f.putPrivateResource)
+	m.HandleFunc("DELETE /policies/v2/privateResources/{id}", f.deletePrivateResource)
+	m.HandleFunc("GET /policies/v2/privateResourceGroups", f.listPrivateResourceGroups)
+	m.HandleFunc("POST /policies/v2/privateResourceGroups", f.addPrivateResourceGroup)
+	m.HandleFunc("GET /policies/v2/privateResourceGroups/{id}", f.getPrivateResourceGroup)
+	m.HandleFunc("PUT /policies/v2/privateResourceGroups/{id}", f.putPrivateResourceGroup)
+	m.HandleFunc("DELETE /policies/v2/privateResourceGroups/{id}", f.deletePrivateResourceGroup)
+
+	// Deployments
+	m.HandleFunc("GET /deployments/v2/networktunnelgroups", f.listTunnelGroups)
+	m.HandleFunc("POST /deployments/v2/networktunnelgroups", f.addTunnelGroup)
+	m.HandleFunc("GET /deployments/v2/networktunnelgroups/{id}", f.getTunnelGroup)
+	m.HandleFunc("PATCH /deployments/v2/networktunnelgroups/{id}", f.patchTunnelGroup)
+	m.HandleFunc("DELETE /deployments/v2/networktunnelgroups/{id}", f.deleteTunnelGroup)
+	m.HandleFunc("GET /deployments/v2/networktunnelgroups/{id}/state", f.getTunnelGroupState)
+	m.HandleFunc("GET /deployments/v2/regions", f.listRegions)
+
+	m.HandleFunc("GET /deployments/v2/sites", f.listObjects(func() map[int64]map[string]any { return f.sites }))
+	m.HandleFunc("POST /deployments/v2/sites", f.createSite)
+	m.HandleFunc("GET /deployments/v2/sites/{id}", f.getObject(func() map[int64]map[string]any { return f.sites }))
+	m.HandleFunc("PUT /deployments/v2/sites/{id}", f.updateObject(func() map[int64]map[string]any { return f.sites }))
+	m.HandleFunc("DELETE /deployments/v2/sites/{id}", f.deleteObject(func() map[int64]map[string]any { return f.sites }))
+
+	m.HandleFunc("GET /deployments/v2/networks", f.listObjects(func() map[int64]map[string]any { return f.networks }))
+	m.HandleFunc("POST /deployments/v2/networks", f.createNetwork)
+	m.HandleFunc("GET /deployments/v2/networks/{id}", f.getObject(func() map[int64]map[string]any { return f.networks }))
+	m.HandleFunc("PUT /deployments/v2/networks/{id}", f.updateObject(func() map[int64]map[string]any { return f.networks }))
+	m.HandleFunc("DELETE /deployments/v2/networks/{id}", f.deleteObject(func() map[int64]map[string]any { return f.networks }))
+
+	m.HandleFunc("GET /deployments/v2/internalnetworks", f.listObjects(func() map[int64]map[string]any { return f.internalNetworks }))
+	m.HandleFunc("POST /deployments/v2/internalnetworks", f.createInternalNetwork)
+	m.HandleFunc("GET /deployments/v2/internalnetworks/{id}", f.getObject(func() map[int64]map[string]any { return f.internalNetworks }))
+	m.HandleFunc("PUT /deployments/v2/internalnetworks/{id}", f.updateInternalNetwork)
+	m.HandleFunc("DELETE /deployments/v2/internalnetworks/{id}", f.deleteObject(func() map[int64]map[string]any { return f.internalNetworks }))
+
+	m.HandleFunc("GET /deployments/v2/internaldomains", f.listObjects(func() map[int64]map[string]any { return f.internalDomains }))
+	m.HandleFunc("POST /deployments/v2/internaldomains", f.createInternalDomain)
+	m.HandleFunc("GET /deployments/v2/internaldomains/{id}", f.getObject(func() map[int64]map[string]any { return f.internalDomains }))
+	m.HandleFunc("PUT /deployments/v2/internaldomains/{id}", f.updateObject(func() map[int64]map[string]any { return f.internalDomains }))
+	m.HandleFunc("DELETE /deployments/v2/internaldomains/{id}", f.deleteObject(func() map[int64]map[string]any { return f.internalDomains }))
+
+	m.HandleFunc("GET /deployments/v2/roamingcomputers", f.listRoamingComputers)
+	m.HandleFunc("GET /deployments/v2/roamingcomputers/{deviceId}", f.getRoamingComputer)
+	m.HandleFunc("PUT /deployments/v2/roamingcomputers/{deviceId}", f.updateRoamingComputer)
+	m.HandleFunc("DELETE /deployments/v2/roamingcomputers/{deviceId}", f.deleteRoamingComputer)
+
+	m.HandleFunc("POST /deployments/v2/deviceSettings/SWGEnabled/list", f.listSWGDeviceSettings)
+	m.HandleFunc("POST /deployments/v2/deviceSettings/SWGEnabled/set", f.setSWGDeviceSettings)
+	m.HandleFunc("POST /deployments/v2/deviceSettings/SWGEnabled/remove", f.removeSWGDeviceSettings)
+
+	m.HandleFunc("GET /deployments/v2/connectorAgents", f.listConnectors)
+	m.HandleFunc("GET /deployments/v2/connectorAgents/{id}", f.getConnector)
+	m.HandleFunc("PATCH /deployments/v2/connectorAgents/{id}", f.patchConnector)
+	m.HandleFunc("DELETE /deployments/v2/connectorAgents/{id}", f.deleteConnector)
+	m.HandleFunc("GET /deployments/v2/connectorGroups", f.listConnectorGroups)
+	m.HandleFunc("POST /deployments/v2/connectorGroups", f.createConnectorGroup)
+	m.HandleFunc("GET /deployments/v2/connectorGroups/{id}", f.getConnectorGroup)
+	m.HandleFunc("PUT /deployments/v2/connectorGroups/{id}", f.putConnectorGroup)
+	m.HandleFunc("DELETE /deployments/v2/connectorGroups/{id}", f.deleteConnectorGroup)
+	m.HandleFunc("PATCH /deployments/v2/connectorGroups/{id}", f.patchConnectorGroup)
+
+	// Reports
+	m.HandleFunc("GET /reports/v2/identities", f.listIdentities)
+
+	m.HandleFunc("GET /admin/v2/managed/customers", f.listChildOrgs)
+}
+
+// seed populates the read-only fixtures the real organization would already
+// contain: identities, content category settings, policy settings and the
+// child organizations managed by the organization.
+func (f *fakeAPIServer) seed() {
+	for i, name := range []string{"Globex", "Acme Corp", "Initech"} {
+		f.childOrgs = append(f.childOrgs, map[string]any{
+			"customerId":   json.Number(strconv.Itoa(fakeAPIOrgID + 1 + i)),
+			"customerName": name,
+			"seats":        json.Number(strconv.Itoa(100 * (i + 1))),
+			"createdAt":    fakeTimestamp(),
+		})
+	}
+
+	f.identities = []map[string]any{
+		{"id": json.Number("3000001"), "label": "Test User (tfacc@example.com)", "deleted": false,
+			"type": map[string]any{"id": json.Number("7"), "label": "Directory Users", "type": identityTypeUser}},
+		{"id": json.Number("3000002"), "label": "tfacc\\Engineering", "deleted": false,
+			"type": map[string]any{"id": json.Number("6"), "label": "Directory Groups", "type": identityTypeGroup}},
+	}
+
+	for i, name := range []string{"Default Settings", "Strict Settings"} {
+		f.contentCategories = append(f.contentCategories, map[string]any{
+			"id":             json.Number(strconv.Itoa(2000001 + i)),
+			"organizationId": json.Number(strconv.Itoa(fakeAPIOrgID)),
+			"isDefault":      i == 0,
+			"name":           name,
+			"categoryBits":   "0",
+			"type":           "CATEGORY",
+			"createdAt":      json.Number(strconv.FormatInt(time.Now().Unix(), 10)),
+			"modifiedAt":     json.Number(strconv.FormatInt(time.Now().Unix(), 10)),
+		})
+	}
+
+	for _, setting := range []struct {
+		name  string
+		value any
+	}{
+		{"sse.globalIPSEnabled", false},
+		{"umbrella.posture.ipsProfileId", json.Number("1")},
+	} {
+		f.policySettings[setting.name] = map[string]any{
+			"settingName":  setting.name,
+			"settingValue": setting.value,
+			"settingId":    json.Number(strconv.FormatInt(f.newID(), 10)),
+			"isGlobal":     true,
+			"createdAt":    fakeTimestamp(),
+			"modifiedAt":   fakeTimestamp(),
+		}
+	}
+}
+
+// SeedRoamingComputer registers a roaming computer, which can only be created
+// by installing the Secure Client, and returns its device ID.
+func (f *fakeAPIServer) SeedRoamingComputer(name string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	deviceID := fakeRandomHex(8)
+	f.roamingComputers[deviceID] = map[string]any{
+		"originId":          json.Number(strconv.FormatInt(f.newID(), 10)),
+		"deviceId":          deviceID,
+		"name":              name,
+		"type":              "anyconnect",
+		"status":            "Protected",
+		"swgStatus":         "Protected",
+		"lastSyncStatus":    "Encrypted",
+		"lastSyncSwgStatus": "Protected",
+		"lastSync":          fakeTimestamp(),
+		"appliedBundle":     json.Number("1"),
+		"hasIpBlocking":     false,
+		"version":           "5.1.2.42",
+		"osVersion":         "14.5.0",
+		"osVersionName":     "macOS Sonoma",
+	}
+	return deviceID
+}
+
+// SeedRoamingComputerOriginID registers a roaming computer and returns its
+// origin ID, for resources that address devices by origin.
+func (f *fakeAPIServer) SeedRoamingComputerOriginID(name string) int64 {
+	deviceID := f.SeedRoamingComputer(name)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	originID, _ := f.roamingComputers[deviceID]["originId"].(json.Number).Int64()
+	return originID
+}
+
+// SeedConnectorGroup registers an empty resource connector group and returns
+// its ID.
+func (f *fakeAPIServer) SeedConnectorGroup(name string) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.seedConnectorGroup(name, 0)
+}
+
+func (f *fakeAPIServer) seedConnectorGroup(name string, connectors int) int64 {
+	groupID := f.newID()
+	f.connectorGroups[groupID] = map[string]any{
+		"id":                       json.Number(strconv.FormatInt(groupID, 10)),
+		"name":                     name,
+		"location":                 "us-west-2",
+		"environment":              "aws",
+		"provisioningKey":          fakeRandomHex(16),
+		"provisioningKeyExpiresAt": time.Now().Add(30 * 24 * time.Hour).UTC().Format(time.RFC3339),
+		"status":                   "connected",
+		"connectorsCount":          json.Number(strconv.Itoa(connectors)),
+		"createdAt":                fakeTimestamp(),
+		"modifiedAt":               fakeTimestamp(),
+	}
+	return groupID
+}
+
+// SeedConnectorAgent registers a resource connector agent, which can only be
+// created by deploying a connector, in a new connector group. It returns the
+// agent's instance ID; the hostname is the same value.
+func (f *fakeAPIServer) SeedConnectorAgent(name string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	groupID := f.seedConnectorGroup(name+"-group", 1)
+
+	instanceID := name + "-" + fakeRandomHex(4)
+	f.seedConnector(groupID, instanceID, "connected")
+	return instanceID
+}
+
+// SeedGroupConnector registers a resource connector agent reporting status in
+// an existing connector group.
+func (f *fakeAPIServer) SeedGroupConnector(groupID int64, instanceID, status string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.seedConnector(groupID, instanceID, status)
+	count := 0
+	for _, connector := range f.connectors {
+		if connector["groupId"] == f.connectorGroups[groupID]["id"] {
+			count++
+		}
+	}
+	f.connectorGroups[groupID]["connectorsCount"] = json.Number(strconv.Itoa(count))
+}
+
+func (f *fakeAPIServer) seedConnector(groupID int64, instanceID, status string) {
+	id := f.newID()
+	f.connectors[id] = map[string]any{
+		"id":              json.Number(strconv.FormatInt(id, 10)),
+		"groupId":         json.Number(strconv.FormatInt(groupID, 10)),
+		"instanceId":      instanceID,
+		"hostname":        instanceID,
+		"confirmed":       false,
+		"enabled":         true,
+		"version":         "1.2.3",
+		"originIpAddress": "198.51.100.10",
+		"status":          status,
+		"statusUpdatedAt": fakeTimestamp(),
+		"createdAt":       fakeTimestamp(),
+		"modifiedAt":      fakeTimestamp(),
+	}
+}
+
+func (f *fakeAPIServer) issueToken(w http.ResponseWriter, r *http.Request) {
+	keyID, keySecret, ok := r.BasicAuth()
+	if !ok {
+		_ = r.ParseForm()
+		keyID, keySecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if keyID != fakeAPIKeyID || keySecret != fakeAPIKeySecret {
+		fakeError(w, http.StatusUnauthorized, "invalid client credentials")
+		return
+	}
+	if orgID := r.Header.Get(childOrgHeader); orgID != "" && !f.isChildOrg(orgID) {
+		fakeError(w, http.StatusForbidden, "organization "+orgID+" is not managed by this organization")
+		return
+	}
+
+	if f.token == "" {
+		f.token = fakeRandomHex(16)
+	}
+	fakeJSON(w, http.StatusOK, map[string]any{
+		"access_token": f.token,
+		"token_type":   "bearer",
+		"expires_in":   3600,
+	})
+}
+
+func (f *fakeAPIServer) isChildOrg(orgID string) bool {
+	for _, org := range f.childOrgs {
+		if org["customerId"].(json.Number).String() == orgID {
+			return true
+		}
+	}
+	return false
+}
+
+// --- Admin: managed child organizations ---
+
+func (f *fakeAPIServer) listChildOrgs(w http.ResponseWriter, r *http.Request) {
+	fakeJSON(w, http.StatusOK, f.childOrgs)
+}
+
+// --- Policies: rules and settings ---
+
+func (f *fakeAPIServer) listRules(w http.ResponseWriter, r *http.Request) {
+	result := make([]map[string]any, 0, len(f.rules))
+	for _, id := range fakeSortedIDs(f.rules) {
+		result = append(result, f.rules[id])
+	}
+	fakeJSON(w, http.StatusOK, map[string]any{"count": len(result), "result": result})
+}
+
+func (f *fakeAPIServer) addRule(w http.ResponseWriter, r *http.Request) {
+	rule, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+
+	id := f.newID()
+	rule["ruleId"] = json.Number(strconv.FormatInt(id, 10))
+	rule["organizationId"] = json.Number(strconv.Itoa(fakeAPIOrgID))
+	rule["ruleIsDefault"] = false
+	if _, set := rule["rulePriority"]; !set {
+		rule["rulePriority"] = json.Number(strconv.Itoa(len(f.rules) + 1))
+	}
+	rule["createdAt"] = fakeTimestamp()
+	rule["modifiedAt"] = fakeTimestamp()
+	f.rules[id] = rule
+	fakeJSON(w, http.StatusOK, rule)
+}
+
+func (f *fakeAPIServer) getRule(w http.ResponseWriter, r *http.Request) {
+	if rule, ok := fakeLookup(w, r, f.rules); ok {
+		fakeJSON(w, http.StatusOK, rule)
+	}
+}
+
+func (f *fakeAPIServer) putRule(w http.ResponseWriter, r *http.Request) {
+	rule, ok := fakeLookup(w, r, f.rules)
+	if !ok {
+		return
+	}
+	update, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+
+	for _, key := range []string{"ruleName", "ruleDescription", "ruleAction", "rulePriority", "ruleIsEnabled", "ruleConditions", "ruleSettings"} {
+		if value, set := update[key]; set {
+			rule[key] = value
+		} else {
+			delete(rule, key)
+		}
+	}
+	rule["modifiedAt"] = fakeTimestamp()
+	fakeJSON(w, http.StatusOK, rule)
+}
+
+func (f *fakeAPIServer) deleteRule(w http.ResponseWriter, r *http.Request) {
+	if id, ok := fakePathID(w, r, f.rules); ok {
+		delete(f.rules, id)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (f *fakeAPIServer) listPolicySettings(w http.ResponseWriter, r *http.Request) {
+	names := make([]string, 0, len(f.policySettings))
+	for name := range f.policySettings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	settings := make([]map[string]any, 0, len(names))
+	for _, name := range names {
+		settings = append(settings, f.policySettings[name])
+	}
+	fakeJSON(w, http.StatusOK, settings)
+}
+
+func (f *fakeAPIServer) putPolicySetting(w http.ResponseWriter, r *http.Request) {
+	update, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+
+	name := r.PathValue("name")
+	setting, exists := f.policySettings[name]
+	if !exists {
+		setting = map[string]any{
+			"settingName": name,
+			"settingId":   json.Number(strconv.FormatInt(f.newID(), 10)),
+			"isGlobal":    true,
+			"createdAt":   fakeTimestamp(),
+		}
+		f.policySettings[name] = setting
+	}
+	setting["settingValue"] = update["settingValue"]
+	setting["modifiedAt"] = fakeTimestamp()
+	fakeJSON(w, http.StatusOK, setting)
+}
+
+func (f *fakeAPIServer) listContentCategories(w http.ResponseWriter, r *http.Request) {
+	fakeJSON(w, http.StatusOK, fakePage(f.contentCategories, r, "page"))
+}
+
+// --- Policies: destination lists ---
+
+func (f *fakeAPIServer) listDestinationLists(w http.ResponseWriter, r *http.Request) {
+	lists := make([]map[string]any, 0, len(f.destinationLists))
+	for _, id := range fakeSortedIDs(f.destinationLists) {
+		lists = append(lists, f.destinationListWithMeta(id))
+	}
+	page := fakePage(lists, r, "page")
+	fakeJSON(w, http.StatusOK, map[string]any{
+		"status": fakeStatusOK(),
+		"meta":   map[string]any{"page": fakeQueryInt(r, "page", 1), "limit": len(page), "total": len(lists)},
+		"data":   page,
+	})
+}
+
+func (f *fakeAPIServer) createDestinationList(w http.ResponseWriter, r *http.Request) {
+	request, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+
+	id := f.newID()
+	list := map[string]any{
+		"id":                   json.Number(strconv.FormatInt(id, 10)),
+		"organizationId":       json.Number(strconv.Itoa(fakeAPIOrgID)),
+		"access":               request["access"],
+		"isGlobal":             request["isGlobal"],
+		"name":                 request["name"],
+		"thirdpartyCategoryId": json.Number("0"),
+		"createdAt":            json.Number(strconv.FormatInt(time.Now().Unix(), 10)),
+		"modifiedAt":           json.Number(strconv.FormatInt(time.Now().Unix(), 10)),
+		"isMspDefault":         false,
+		"markedForDeletion":    false,
+	}
+	if bundleTypeID, set := request["bundleTypeId"]; set {
+		list["bundleTypeId"] = bundleTypeID
+	}
+	f.destinationLists[id] = list
+
+	destinations, _ := request["destinations"].([]any)
+	f.appendDestinations(id, destinations)
+
+	fakeJSON(w, http.StatusOK, map[string]any{"status": fakeStatusOK(), "data": f.destinationListWithMeta(id)})
+}
+
+func (f *fakeAPIServer) getDestinationList(w http.ResponseWriter, r *http.Request) {
+	if id, ok := fakePathID(w, r, f.destinationLists); ok {
+		fakeJSON(w, http.StatusOK, map[string]any{"status": fakeStatusOK(), "data": f.destinationListWithMeta(id)})
+	}
+}
+
+func (f *fakeAPIServer) patchDestinationList(w http.ResponseWriter, r *http.Request) {
+	id, ok := fakePathID(w, r, f.destinationLists)
+	if !ok {
+		return
+	}
+	update, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+
+	f.destinationLists[id]["name"] = update["name"]
+	f.destinationLists[id]["modifiedAt"] = json.Number(strconv.FormatInt(time.Now().Unix(), 10))
+	fakeJSON(w, http.StatusOK, map[string]any{"status": fakeStatusOK(), "data": f.destinationListWithMeta(id)})
+}
+
+func (f *fakeAPIServer) deleteDestinationList(w http.ResponseWriter, r *http.Request) {
+	if id, ok := fakePathID(w, r, f.destinationLists); ok {
+		delete(f.destinationLists, id)
+		delete(f.destinations, id)
+		fakeJSON(w, http.StatusOK, map[string]any{"status": fakeStatusOK(), "data": []any{}})
+	}
+}
+
+func (f *fakeAPIServer) listDestinations(w http.ResponseWriter, r *http.Request) {
+	id, ok := fakePathID(w, r, f.destinationLists)
+	if !ok {
+		return
+	}
+
+	page := fakePage(f.destinations[id], r, "page")
+	fakeJSON(w, http.StatusOK, map[string]any{
+		"status": fakeStatusOK(),
+		"meta":   map[string]any{"page": fakeQueryInt(r, "page", 1), "limit": len(page), "total": len(f.destinations[id])},
+		"data":   page,
+	})
+}
+
+func (f *fakeAPIServer) addDestinations(w http.ResponseWriter, r *http.Request) {
+	id, ok := fakePathID(w, r, f.destinationLists)
+	if !ok {
+		return
+	}
+	var destinations []any
+	if !fakeDecodeInto(w, r, &destinations) {
+		return
+	}
+
+	f.appendDestinations(id, destinations)
+	fakeJSON(w, http.StatusOK, map[string]any{"status": fakeStatusOK(), "data": f.destinationListWithMeta(id)})
+}
+
+func (f *fakeAPIServer) removeDestinations(w http.ResponseWriter, r *http.Request) {
+	id, ok := fakePathID(w, r, f.destinationLists)
+	if !ok {
+		return
+	}
+	var destinationIDs []json.Number
+	if !fakeDecodeInto(w, r, &destinationIDs) {
+		return
+	}
+
+	remove := make(map[string]bool, len(destinationIDs))
+	for _, destinationID := range destinationIDs {
+		remove[destinationID.String()] = true
+	}
+	kept := f.destinations[id][:0]
+	for _, destination := range f.destinations[id] {
+		if !remove[destination["id"].(string)] {
+			kept = append(kept, destination)
+		}
+	}
+	f.destinations[id] = kept
+	fakeJSON(w, http.StatusOK, map[string]any{"status": fakeStatusOK(), "data": f.destinationListWithMeta(id)})
+}
+
+func (f *fakeAPIServer) appendDestinations(listID int64, destinations []any) {
+	for _, raw := range destinations {
+		request, _ := raw.(map[string]any)
+		value, _ := request["destination"].(string)
+		destinationType, _ := request["type"].(string)
+		if destinationType == "" {
+			destinationType = fakeDestinationType(value)
+		}
+
+		destination := map[string]any{
+			"id":          strconv.FormatInt(f.newID(), 10),
+			"destination": value,
+			"type":        destinationType,
+			"createdAt":   time.Now().UTC().Format("2006-01-02 15:04:05"),
+		}
+		if comment, set := request["comment"]; set {
+			destination["comment"] = comment
+		}
+		f.destinations[listID] = append(f.destinations[listID], destination)
+	}
+}
+
+func (f *fakeAPIServer) destinationListWithMeta(id int64) map[string]any {
+	list := fakeCopy(f.destinationLists[id])
+	counts := map[string]int{}
+	for _, destination := range f.destinations[id] {
+		counts[destination["type"].(string)]++
+	}
+	list["meta"] = map[string]any{
+		"destinationCount": len(f.destinations[id]),
+		"domainCount":      counts["domain"],
+		"urlCount":         counts["url"],
+		"ipv4Count":        counts["ipv4"],
+	}
+	return list
+}
+
+// fakeDestinationType infers the destination type the way the API does when
+// destinations are added without one.
+func fakeDestinationType(destination string) string {
+	if _, _, err := net.ParseCIDR(destination); err == nil {
+		return "ipv4"
+	}
+	if net.ParseIP(destination) != nil {
+		return "ipv4"
+	}
+	if strings.Contains(destination, "/") {
+		return "url"
+	}
+	return "domain"
+}
+
+// --- Policies: private resources and resource groups ---
+
+func (f *fakeAPIServer) listPrivateResources(w http.ResponseWriter, r *http.Request) {
+	items := make([]map[string]any, 0, len(f.privateResources))
+	for _, id := range fakeSortedIDs(f.privateResources) {
+		items = append(items, f.privateResources[id])
+	}
+	items = fakeFilter(items, r)
+	fakeJSON(w, http.StatusOK, map[string]any{"items": items, "offset": 0, "limit": len(items), "total": len(items)})
+}
+
+func (f *fakeAPIServer) addPrivateResource(w http.ResponseWriter, r *http.Request) {
+	request, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+	if f.nameTaken(f.privateResources, request["name"], 0) {
+		fakeError(w, http.StatusConflict, "a private resource with this name already exists")
+		return
+	}
+
+	id := f.newID()
+	resource := map[string]any{
+		"resourceId": json.Number(strconv.FormatInt(id, 10)),
+		"createdAt":  fakeTimestamp(),
+		"createdBy":  "fake@example.com",
+	}
+	f.applyPrivateResource(resource, request)
+	f.privateResources[id] = resource
+	fakeJSON(w, http.StatusCreated, resource)
+}
+
+func (f *fakeAPIServer) getPrivateResource(w http.ResponseWriter, r *http.Request) {
+	if resource, ok := fakeLookup(w, r, f.privateResources); ok {
+		fakeJSON(w, http.StatusOK, resource)
+	}
+}
+
+func (f *fakeAPIServer) putPrivateResource(w http.ResponseWriter, r *http.Request) {
+	id, ok := fakePathID(w, r, f.privateResources)
+	if !ok {
+		return
+	}
+	request, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+	if f.nameTaken(f.privateResources, request["name"], id) {
+		fakeError(w, http.StatusConflict, "a private resource with this name already exists")
+		return
+	}
+
+	f.applyPrivateResource(f.privateResources[id], request)
+	fakeJSON(w, http.StatusOK, f.privateResources[id])
+}
+
+func (f *fakeAPIServer) deletePrivateResource(w http.ResponseWriter, r *http.Request) {
+	if id, ok := fakePathID(w, r, f.privateResources); ok {
+		delete(f.privateResources, id)
+		for _, group := range f.privateResourceGroups {
+			group["resourceIds"] = fakeWithout(group["resourceIds"], id)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// applyPrivateResource copies a private resource request onto the stored
+// response, converting browser access prefixes into the generated FQDN.
+func (f *fakeAPIServer) applyPrivateResource(resource, request map[string]any) {
+	for _, key := range []string{"name", "description", "dnsServerId", "certificateId", "resourceAddresses", "resourceGroupIds"} {
+		if value, set := request[key]; set {
+			resource[key] = value
+		} else {
+			delete(resource, key)
+		}
+	}
+
+	accessTypes, _ := request["accessTypes"].([]any)
+	converted := make([]any, 0, len(accessTypes))
+	for _, raw := range accessTypes {
+		access := fakeCopy(raw.(map[string]any))
+		if prefix, set := access["externalFQDNPrefix"]; set {
+			delete(access, "externalFQDNPrefix")
+			access["externalFQDN"] = fmt.Sprintf("%s-%d.ztna.sse.cisco.io", prefix, fakeAPIOrgID)
+		}
+		converted = append(converted, access)
+	}
+	resource["accessTypes"] = converted
+	resource["modifiedAt"] = fakeTimestamp()
+	resource["modifiedBy"] = "fake@example.com"
+}
+
+func (f *fakeAPIServer) listPrivateResourceGroups(w http.ResponseWriter, r *http.Request) {
+	items := make([]map[string]any, 0, len(f.privateResourceGroups))
+	for _, id := range fakeSortedIDs(f.privateResourceGroups) {
+		items = append(items, f.privateResourceGroups[id])
+	}
+	items = fakeFilter(items, r)
+	fakeJSON(w, http.StatusOK, map[string]any{"items": items, "offset": 0, "limit": len(items), "total": len(items)})
+}
+
+func (f *fakeAPIServer) addPrivateResourceGroup(w http.ResponseWriter, r *http.Request) {
+	request, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+	if f.nameTaken(f.privateResourceGroups, request["name"], 0) {
+		fakeError(w, http.StatusConflict, "a private resource group with this name already exists")
+		return
+	}
+
+	id := f.newID()
+	group := map[string]any{
+		"resourceGroupId": json.Number(strconv.FormatInt(id, 10)),
+		"createdAt":       fakeTimestamp(),
+		"createdBy":       "fake@example.com",
+	}
+	f.applyPrivateResourceGroup(group, request)
+	f.privateResourceGroups[id] = group
+	fakeJSON(w, http.StatusCreated, group)
+}
+
+func (f *fakeAPIServer) getPrivateResourceGroup(w http.ResponseWriter, r *http.Request) {
+	if group, ok := fakeLookup(w, r, f.privateResourceGroups); ok {
+		fakeJSON(w, http.StatusOK, group)
+	}
+}
+
+func (f *fakeAPIServer) putPrivateResourceGroup(w http.ResponseWriter, r *http.Request) {
+	id, ok := fakePathID(w, r, f.privateResourceGroups)
+	if !ok {
+		return
+	}
+	request, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+
+	f.applyPrivateResourceGroup(f.privateResourceGroups[id], request)
+	fakeJSON(w, http.StatusOK, f.privateResourceGroups[id])
+}
+
+func (f *fakeAPIServer) deletePrivateResourceGroup(w http.ResponseWriter, r *http.Request) {
+	if id, ok := fakePathID(w, r, f.privateResourceGroups); ok {
+		delete(f.privateResourceGroups, id)
+		for _, resource := range f.privateResources {
+			resource["resourceGroupIds"] = fakeWithout(resource["resourceGroupIds"], id)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (f *fakeAPIServer) applyPrivateResourceGroup(group, request map[string]any) {
+	for _, key := range []string{"name", "description", "resourceIds"} {
+		if value, set := request[key]; set {
+			group[key] = value
+		} else {
+			delete(group, key)
+		}
+	}
+	group["modifiedAt"] = fakeTimestamp()
+	group["modifiedBy"] = "fake@example.com"
+}
+
+// --- Deployments: network tunnel groups ---
+
+func (f *fakeAPIServer) listTunnelGroups(w http.ResponseWriter, r *http.Request) {
+	data := make([]map[string]any, 0, len(f.tunnelGroups))
+	for _, id := range fakeSortedIDs(f.tunnelGroups) {
+		data = append(data, fakeTunnelGroupResponse(f.tunnelGroups[id]))
+	}
+	data = fakeFilter(data, r)
+	fakeJSON(w, http.StatusOK, map[string]any{"data": data, "offset": 0, "limit": len(data), "total": len(data)})
+}
+
+func (f *fakeAPIServer) addTunnelGroup(w http.ResponseWriter, r *http.Request) {
+	request, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+	if f.nameTaken(f.tunnelGroups, request["name"], 0) {
+		fakeError(w, http.StatusConflict, "a network tunnel group with this name already exists")
+		return
+	}
+
+	group := f.seedTunnelGroup(request)
+	fakeJSON(w, http.StatusOK, fakeTunnelGroupResponse(group))
+}
+
+// seedTunnelGroup stores a tunnel group created from an add request, with a
+// primary and a secondary hub in its region.
+func (f *fakeAPIServer) seedTunnelGroup(request map[string]any) map[string]any {
+	authIDPrefix, _ := request["authIdPrefix"].(string)
+	region, _ := request["region"].(string)
+	id := f.newID()
+	group := map[string]any{
+		"id":             json.Number(strconv.FormatInt(id, 10)),
+		"name":           request["name"],
+		"organizationId": json.Number(strconv.Itoa(fakeAPIOrgID)),
+		"deviceType":     request["deviceType"],
+		"region":         region,
+		"status":         "disconnected",
+		"passphrase":     request["passphrase"],
+		"authIdPrefix":   authIDPrefix,
+		"routing":        request["routing"],
+		"createdAt":      fakeTimestamp(),
+		"modifiedAt":     fakeTimestamp(),
+	}
+	if group["deviceType"] == nil {
+		group["deviceType"] = "other"
+	}
+
+	hubs := make([]any, 0, 2)
+	for i, primary := range []bool{true, false} {
+		hubs = append(hubs, map[string]any{
+			"id":        json.Number(strconv.FormatInt(f.newID(), 10)),
+			"isPrimary": primary,
+			"datacenter": map[string]any{
+				"name": fmt.Sprintf("%s-%d", region, i+1),
+				"ip":   fmt.Sprintf("203.0.113.%d", (id+int64(i))%250+1),
+			},
+			"authId":       fmt.Sprintf("%s@%d-%d-sse.cisco.com", authIDPrefix, fakeAPIOrgID, id*10+int64(i)),
+			"status":       map[string]any{"status": "disconnected", "time": fakeTimestamp()},
+			"tunnelsCount": json.Number("0"),
+		})
+	}
+	group["hubs"] = hubs
+
+	f.tunnelGroups[id] = group
+	return group
+}
+
+func (f *fakeAPIServer) getTunnelGroup(w http.ResponseWriter, r *http.Request) {
+	if group, ok := fakeLookup(w, r, f.tunnelGroups); ok {
+		fakeJSON(w, http.StatusOK, fakeTunnelGroupResponse(group))
+	}
+}
+
+func (f *fakeAPIServer) patchTunnelGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := fakeLookup(w, r, f.tunnelGroups)
+	if !ok {
+		return
+	}
+	var operations []map[string]any
+	if !fakeDecodeInto(w, r, &operations) {
+		return
+	}
+
+	for _, operation := range operations {
+		field := strings.TrimPrefix(fmt.Sprint(operation["path"]), "/")
+		switch field {
+		case "name", "passphrase", "routing":
+			group[field] = operation["value"]
+		default:
+			fakeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported patch path %q", operation["path"]))
+			return
+		}
+	}
+	group["modifiedAt"] = fakeTimestamp()
+	fakeJSON(w, http.StatusOK, fakeTunnelGroupResponse(group))
+}
+
+func (f *fakeAPIServer) deleteTunnelGroup(w http.ResponseWriter, r *http.Request) {
+	if id, ok := fakePathID(w, r, f.tunnelGroups); ok {
+		delete(f.tunnelGroups, id)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// fakeTunnelGroupResponse strips the write-only fields from a stored tunnel
+// group.
+// fakeRegions are the regions tunnel groups can be created in. The filters
+// of the regions API are ignored.
+var fakeRegions = []map[string]any{
+	{"name": "US Test 2", "region": "us-test-2", "description": "Test City, US", "continent": "North America"},
+	{"name": "EU Test 1", "region": "eu-test-1", "description": "Test City, DE", "continent": "Europe"},
+}
+
+func (f *fakeAPIServer) listRegions(w http.ResponseWriter, r *http.Request) {
+	fakeJSON(w, http.StatusOK, map[string]any{
+		"regions": fakeRegions,
+		"bgp": map[string]any{
+			"asNumber":  "64512",
+			"peerIPs":   []string{"169.254.0.9", "169.254.0.13"},
+			"peerRange": "169.254.0.0/24",
+		},
+	})
+}
+
+// getTunnelGroupState reports the hubs of a tunnel group and the tunnels
+// seeded by SeedConnectedTunnelGroup; no device connects to the fake.
+func (f *fakeAPIServer) getTunnelGroupState(w http.ResponseWriter, r *http.Request) {
+	group, ok := fakeLookup(w, r, f.tunnelGroups)
+	if !ok {
+		return
+	}
+
+	hubs := make([]any, 0, 2)
+	for _, value := range group["hubs"].([]any) {
+		hub := value.(map[string]any)
+		tunnels, _ := hub["tunnelsStatus"].([]any)
+		if tunnels == nil {
+			tunnels = []any{}
+		}
+		hubs = append(hubs, map[string]any{
+			"id":            hub["id"],
+			"isPrimary":     hub["isPrimary"],
+			"datacenter":    map[string]any{"name": hub["datacenter"].(map[string]any)["name"]},
+			"status":        hub["status"],
+			"tunnelsStatus": tunnels,
+		})
+	}
+	fakeJSON(w, http.StatusOK, map[string]any{
+		"id":             group["id"],
+		"name":           group["name"],
+		"organizationId": group["organizationId"],
+		"status":         group["status"],
+		"hubs":           hubs,
+	})
+}
+
+// SeedConnectedTunnelGroup creates a tunnel group named name whose primary
+// hub has an established tunnel from a device with IP address peerIP.
+func (f *fakeAPIServer) SeedConnectedTunnelGroup(name, peerIP string) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	group := f.seedTunnelGroup(map[string]any{
+		"name":         name,
+		"region":       "us-test-2",
+		"authIdPrefix": "fakeseed",
+		"routing":      map[string]any{"type": "static", "data": map[string]any{"networkCIDRs": []any{"10.10.110.0/24"}}},
+	})
+	group["status"] = "connected"
+	hub := group["hubs"].([]any)[0].(map[string]any)
+	hub["status"] = map[string]any{"status": "UP", "time": fakeTimestamp()}
+	hub["tunnelsStatus"] = []any{map[string]any{
+		"time":       fakeTimestamp(),
+		"status":     "UP",
+		"dcName":     hub["datacenter"].(map[string]any)["name"],
+		"ikeState":   "ESTABLISHED",
+		"ipsecState": "INSTALLED",
+		"peerId":     hub["authId"],
+		"peerIp":     peerIP,
+		"localIp":    peerIP,
+	}}
+
+	id, _ := group["id"].(json.Number).Int64()
+	return id
+}
+
+func fakeTunnelGroupResponse(group map[string]any) map[string]any {
+	response := fakeCopy(group)
+	delete(response, "passphrase")
+	delete(response, "authIdPrefix")
+	return response
+}
+
+// --- Deployments: sites, networks, internal networks and internal domains ---
+
+func (f *fakeAPIServer) createSite(w http.ResponseWriter, r *http.Request) {
+	request, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+
+	id := f.newID()
+	f.sites[id] = map[string]any{
+		"originId":             json.Number(strconv.FormatInt(id, 10)),
+		"siteId":               json.Number(strconv.FormatInt(f.newID(), 10)),
+		"name":                 request["name"],
+		"isDefault":            false,
+		"type":                 "site",
+		"internalNetworkCount": json.Number("0"),
+		"vaCount":              json.Number("0"),
+		"createdAt":            fakeTimestamp(),
+		"modifiedAt":           fakeTimestamp(),
+	}
+	fakeJSON(w, http.StatusOK, f.sites[id])
+}
+
+func (f *fakeAPIServer) createNetwork(w http.ResponseWriter, r *http.Request) {
+	request, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+
+	id := f.newID()
+	network := map[string]any{
+		"originId":   json.Number(strconv.FormatInt(id, 10)),
+		"isVerified": false,
+		"createdAt":  fakeTimestamp(),
+	}
+	for key, value := range request {
+		network[key] = value
+	}
+	if _, set := network["ipAddress"]; !set {
+		network["ipAddress"] = ""
+	}
+	f.networks[id] = network
+	fakeJSON(w, http.StatusOK, network)
+}
+
+func (f *fakeAPIServer) createInternalNetwork(w http.ResponseWriter, r *http.Request) {
+	request, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+
+	id := f.newID()
+	network := map[string]any{
+		"originId":  json.Number(strconv.FormatInt(id, 10)),
+		"createdAt": fakeTimestamp(),
+	}
+	f.applyInternalNetwork(network, request)
+	f.internalNetworks[id] = network
+	fakeJSON(w, http.StatusOK, network)
+}
+
+func (f *fakeAPIServer) updateInternalNetwork(w http.ResponseWriter, r *http.Request) {
+	network, ok := fakeLookup(w, r, f.internalNetworks)
+	if !ok {
+		return
+	}
+	request, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+
+	f.applyInternalNetwork(network, request)
+	fakeJSON(w, http.StatusOK, network)
+}
+
+// applyInternalNetwork copies an internal network request onto the stored
+// object and resolves the name of the site, network or tunnel it belongs to.
+func (f *fakeAPIServer) applyInternalNetwork(network, request map[string]any) {
+	for _, key := range []string{"name", "ipAddress", "prefixLength"} {
+		network[key] = request[key]
+	}
+	for _, key := range []string{"siteName", "siteId", "networkName", "networkId", "tunnelName", "tunnelId"} {
+		delete(network, key)
+	}
+
+	if siteID, set := request["siteId"]; set {
+		network["siteId"] = siteID
+		for _, site := range f.sites {
+			if site["siteId"] == siteID || site["originId"] == siteID {
+				network["siteName"] = site["name"]
+			}
+		}
+	}
+	if networkID, set := request["networkId"]; set {
+		network["networkId"] = networkID
+		if id, err := networkID.(json.Number).Int64(); err == nil && f.networks[id] != nil {
+			network["networkName"] = f.networks[id]["name"]
+		}
+	}
+	if tunnelID, set := request["tunnelId"]; set {
+		network["tunnelId"] = tunnelID
+		if id, err := tunnelID.(json.Number).Int64(); err == nil && f.tunnelGroups[id] != nil {
+			network["tunnelName"] = f.tunnelGroups[id]["name"]
+		}
+	}
+	network["modifiedAt"] = fakeTimestamp()
+}
+
+func (f *fakeAPIServer) createInternalDomain(w http.ResponseWriter, r *http.Request) {
+	request, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+
+	id := f.newID()
+	domain := map[string]any{
+		"id":                      json.Number(strconv.FormatInt(id, 10)),
+		"description":             "",
+		"includeAllVAs":           false,
+		"includeAllMobileDevices": false,
+		"siteIds":                 []any{},
+		"createdAt":               fakeTimestamp(),
+		"modifiedAt":              fakeTimestamp(),
+	}
+	for key, value := range request {
+		domain[key] = value
+	}
+	f.internalDomains[id] = domain
+	fakeJSON(w, http.StatusOK, domain)
+}
+
+// listObjects, getObject, updateObject and deleteObject implement the plain
+// CRUD endpoints of the deployments APIs, which return bare objects and
+// arrays.
+func (f *fakeAPIServer) listObjects(store func() map[int64]map[string]any) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		objects := make([]map[string]any, 0, len(store()))
+		for _, id := range fakeSortedIDs(store()) {
+			objects = append(objects, store()[id])
+		}
+		fakeJSON(w, http.StatusOK, fakePage(objects, r, "page"))
+	}
+}
+
+func (f *fakeAPIServer) getObject(store func() map[int64]map[string]any) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if object, ok := fakeLookup(w, r, store()); ok {
+			fakeJSON(w, http.StatusOK, object)
+		}
+	}
+}
+
+func (f *fakeAPIServer) updateObject(store func() map[int64]map[string]any) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		object, ok := fakeLookup(w, r, store())
+		if !ok {
+			return
+		}
+		update, ok := fakeDecode(w, r)
+		if !ok {
+			return
+		}
+
+		for key, value := range update {
+			object[key] = value
+		}
+		if _, tracked := object["modifiedAt"]; tracked {
+			object["modifiedAt"] = fakeTimestamp()
+		}
+		fakeJSON(w, http.StatusOK, object)
+	}
+}
+
+func (f *fakeAPIServer) deleteObject(store func() map[int64]map[string]any) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if id, ok := fakePathID(w, r, store()); ok {
+			delete(store(), id)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+}
+
+// --- Deployments: roaming computers and SWG device settings ---
+
+func (f *fakeAPIServer) listRoamingComputers(w http.ResponseWriter, r *http.Request) {
+	deviceIDs := make([]string, 0, len(f.roamingComputers))
+	for deviceID := range f.roamingComputers {
+		deviceIDs = append(deviceIDs, deviceID)
+	}
+	sort.Strings(deviceIDs)
+
+	computers := make([]map[string]any, 0, len(deviceIDs))
+	for _, deviceID := range deviceIDs {
+		computers = append(computers, f.roamingComputers[deviceID])
+	}
+	fakeJSON(w, http.StatusOK, fakePage(computers, r, "page"))
+}
+
+func (f *fakeAPIServer) getRoamingComputer(w http.ResponseWriter, r *http.Request) {
+	computer, ok := f.roamingComputers[r.PathValue("deviceId")]
+	if !ok {
+		fakeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	fakeJSON(w, http.StatusOK, computer)
+}
+
+func (f *fakeAPIServer) updateRoamingComputer(w http.ResponseWriter, r *http.Request) {
+	computer, ok := f.roamingComputers[r.PathValue("deviceId")]
+	if !ok {
+		fakeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	update, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+
+	computer["name"] = update["name"]
+	fakeJSON(w, http.StatusOK, computer)
+}
+
+func (f *fakeAPIServer) deleteRoamingComputer(w http.ResponseWriter, r *http.Request) {
+	if _, ok := f.roamingComputers[r.PathValue("deviceId")]; !ok {
+		fakeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	delete(f.roamingComputers, r.PathValue("deviceId"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *fakeAPIServer) listSWGDeviceSettings(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		OriginIDs []int64 `json:"originIds"`
+	}
+	if !fakeDecodeInto(w, r, &request) {
+		return
+	}
+
+	settings := make([]map[string]any, 0, len(request.OriginIDs))
+	for _, originID := range request.OriginIDs {
+		if setting, ok := f.swgDeviceSettings[originID]; ok {
+			settings = append(settings, setting)
+		}
+	}
+	fakeJSON(w, http.StatusOK, settings)
+}
+
+func (f *fakeAPIServer) setSWGDeviceSettings(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		OriginIDs []int64 `json:"originIds"`
+		Value     string  `json:"value"`
+	}
+	if !fakeDecodeInto(w, r, &request) {
+		return
+	}
+
+	items := make([]map[string]any, 0, len(request.OriginIDs))
+	for _, originID := range request.OriginIDs {
+		f.swgDeviceSettings[originID] = map[string]any{
+			"originId":   originID,
+			"name":       "SWGEnabled",
+			"value":      request.Value,
+			"modifiedAt": fakeTimestamp(),
+		}
+		items = append(items, map[string]any{"originId": originID, "code": 200, "message": "success"})
+	}
+	fakeJSON(w, http.StatusOK, map[string]any{
+		"totalCount":   len(items),
+		"successCount": len(items),
+		"failCount":    0,
+		"items":        items,
+		"value":        request.Value,
+	})
+}
+
+func (f *fakeAPIServer) removeSWGDeviceSettings(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		OriginIDs []int64 `json:"originIds"`
+	}
+	if !fakeDecodeInto(w, r, &request) {
+		return
+	}
+
+	for _, originID := range request.OriginIDs {
+		delete(f.swgDeviceSettings, originID)
+	}
+	fakeJSON(w, http.StatusOK, map[string]any{"status": "success"})
+}
+
+// --- Deployments: resource connectors ---
+
+func (f *fakeAPIServer) listConnectors(w http.ResponseWriter, r *http.Request) {
+	data := make([]map[string]any, 0, len(f.connectors))
+	for _, id := range fakeSortedIDs(f.connectors) {
+		data = append(data, f.connectors[id])
+	}
+	data = fakeFilter(data, r)
+	fakeJSON(w, http.StatusOK, map[string]any{"data": data, "offset": 0, "limit": len(data), "total": len(data)})
+}
+
+func (f *fakeAPIServer) getConnector(w http.ResponseWriter, r *http.Request) {
+	if connector, ok := fakeLookup(w, r, f.connectors); ok {
+		fakeJSON(w, http.StatusOK, connector)
+	}
+}
+
+func (f *fakeAPIServer) patchConnector(w http.ResponseWriter, r *http.Request) {
+	connector, ok := fakeLookup(w, r, f.connectors)
+	if !ok {
+		return
+	}
+	var operations []map[string]any
+	if !fakeDecodeInto(w, r, &operations) {
+		return
+	}
+
+	for _, operation := range operations {
+		field := strings.TrimPrefix(fmt.Sprint(operation["path"]), "/")
+		switch field {
+		case "confirmed", "enabled":
+			connector[field] = operation["value"]
+		default:
+			fakeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported patch path %q", operation["path"]))
+			return
+		}
+	}
+	connector["modifiedAt"] = fakeTimestamp()
+	fakeJSON(w, http.StatusOK, connector)
+}
+
+func (f *fakeAPIServer) deleteConnector(w http.ResponseWriter, r *http.Request) {
+	if id, ok := fakePathID(w, r, f.connectors); ok {
+		delete(f.connectors, id)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (f *fakeAPIServer) listConnectorGroups(w http.ResponseWriter, r *http.Request) {
+	data := make([]map[string]any, 0, len(f.connectorGroups))
+	for _, id := range fakeSortedIDs(f.connectorGroups) {
+		data = append(data, f.connectorGroupResponse(id, r))
+	}
+	data = fakeFilter(data, r)
+	fakeJSON(w, http.StatusOK, map[string]any{"data": data, "offset": 0, "limit": len(data), "total": len(data)})
+}
+
+func (f *fakeAPIServer) getConnectorGroup(w http.ResponseWriter, r *http.Request) {
+	if id, ok := fakePathID(w, r, f.connectorGroups); ok {
+		fakeJSON(w, http.StatusOK, f.connectorGroupResponse(id, r))
+	}
+}
+
+func (f *fakeAPIServer) createConnectorGroup(w http.ResponseWriter, r *http.Request) {
+	request, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+	if f.nameTaken(f.connectorGroups, request["name"], 0) {
+		fakeError(w, http.StatusConflict, fmt.Sprintf("a connector group named %v already exists", request["name"]))
+		return
+	}
+
+	id := f.seedConnectorGroup(fmt.Sprint(request["name"]), 0)
+	group := f.connectorGroups[id]
+	group["location"] = request["location"]
+	if environment, ok := request["environment"]; ok {
+		group["environment"] = environment
+	}
+	group["status"] = "disconnected"
+	fakeJSON(w, http.StatusOK, f.connectorGroupResponse(id, r))
+}
+
+func (f *fakeAPIServer) putConnectorGroup(w http.ResponseWriter, r *http.Request) {
+	id, ok := fakePathID(w, r, f.connectorGroups)
+	if !ok {
+		return
+	}
+	request, ok := fakeDecode(w, r)
+	if !ok {
+		return
+	}
+	if f.nameTaken(f.connectorGroups, request["name"], id) {
+		fakeError(w, http.StatusConflict, fmt.Sprintf("a connector group named %v already exists", request["name"]))
+		return
+	}
+
+	group := f.connectorGroups[id]
+	for _, field := range []string{"name", "location", "environment"} {
+		if value, ok := request[field]; ok {
+			group[field] = value
+		}
+	}
+	group["modifiedAt"] = fakeTimestamp()
+	fakeJSON(w, http.StatusOK, f.connectorGroupResponse(id, r))
+}
+
+// deleteConnectorGroup refuses to delete a group that still has connectors,
+// as the real API does.
+func (f *fakeAPIServer) deleteConnectorGroup(w http.ResponseWriter, r *http.Request) {
+	id, ok := fakePathID(w, r, f.connectorGroups)
+	if !ok {
+		return
+	}
+	for _, connector := range f.connectors {
+		if connector["groupId"] == f.connectorGroups[id]["id"] {
+			fakeError(w, http.StatusBadRequest, "the connector group has connectors and cannot be deleted")
+			return
+		}
+	}
+	delete(f.connectorGroups, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// patchConnectorGroup renames a group or, for the provisioningKey path,
+// replaces its provisioning key with a new random one.
+func (f *fakeAPIServer) patchConnectorGroup(w http.ResponseWriter, r *http.Request) {
+	id, ok := fakePathID(w, r, f.connectorGroups)
+	if !ok {
+		return
+	}
+	var operations []map[string]any
+	if !fakeDecodeInto(w, r, &operations) {
+		return
+	}
+
+	group := f.connectorGroups[id]
+	for _, operation := range operations {
+		switch strings.TrimPrefix(fmt.Sprint(operation["path"]), "/") {
+		case "name":
+			group["name"] = operation["value"]
+		case "provisioningKey":
+			group["provisioningKey"] = fakeRandomHex(16)
+			group["provisioningKeyExpiresAt"] = time.Now().Add(30 * 24 * time.Hour).UTC().Format(time.RFC3339)
+		default:
+			fakeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported patch path %q", operation["path"]))
+			return
+		}
+	}
+	group["modifiedAt"] = fakeTimestamp()
+	fakeJSON(w, http.StatusOK, f.connectorGroupResponse(id, r))
+}
+
+// connectorGroupResponse omits the provisioning key unless the request asked
+// for it, as the real API does.
+func (f *fakeAPIServer) connectorGroupResponse(id int64, r *http.Request) map[string]any {
+	group := fakeCopy(f.connectorGroups[id])
+	if r.URL.Query().Get("includeProvisioningKey") != "true" {
+		delete(group, "provisioningKey")
+	}
+	return group
+}
+
+// --- Reports ---
+
+func (f *fakeAPIServer) listIdentities(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	search := strings.ToLower(strings.Trim(query.Get("search"), "%"))
+	identityTypes := query.Get("identitytypes")
+
+	matches := make([]map[string]any, 0, len(f.identities))
+	for _, identity := range f.identities {
+		identityType := identity["type"].(map[string]any)["type"].(string)
+		if identityTypes != "" && !strings.Contains(","+identityTypes+",", ","+identityType+",") {
+			continue
+		}
+		if !strings.Contains(strings.ToLower(identity["label"].(string)), search) {
+			continue
+		}
+		matches = append(matches, identity)
+	}
+
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = len(matches)
+	}
+	if offset > len(matches) {
+		offset = len(matches)
+	}
+	end := min(offset+limit, len(matches))
+	fakeJSON(w, http.StatusOK, map[string]any{"data": matches[offset:end], "meta": map[string]any{}})
+}
+
+// --- Helpers ---
+
+func (f *fakeAPIServer) newID() int64 {
+	f.nextID++
+	return f.nextID
+}
+
+// nameTaken reports whether another object in store already uses name.
+func (f *fakeAPIServer) nameTaken(store map[int64]map[string]any, name any, exceptID int64) bool {
+	for id, object := range store {
+		if id != exceptID && object["name"] == name {
+			return true
+		}
+	}
+	return false
+}
+
+func fakeTimestamp() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func fakeRandomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func fakeStatusOK() map[string]any {
+	return map[string]any{"code": 200, "text": "OK"}
+}
+
+// fakeError writes an error body in the shape shared by the Secure Access
+// APIs, including the "Not Found" marker the destination list resource
+// looks for.
+func fakeError(w http.ResponseWriter, code int, message string) {
+	fakeJSON(w, code, map[string]any{
+		"statusCode": code,
+		"error":      http.StatusText(code),
+		"message":    message,
+	})
+}
+
+func fakeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// fakeDecodeInto decodes the request body, keeping numbers as json.Number so
+// that IDs survive the round trip unchanged.
+func fakeDecodeInto(w http.ResponseWriter, r *http.Request, v any) bool {
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		fakeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err))
+		return false
+	}
+	return true
+}
+
+func fakeDecode(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	var body map[string]any
+	return body, fakeDecodeInto(w, r, &body)
+}
+
+func fakePathID(w http.ResponseWriter, r *http.Request, store map[int64]map[string]any) (int64, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		fakeError(w, http.StatusBadRequest, fmt.Sprintf("invalid ID %q", r.PathValue("id")))
+		return 0, false
+	}
+	if _, ok := store[id]; !ok {
+		fakeError(w, http.StatusNotFound, "Not Found")
+		return 0, false
+	}
+	return id, true
+}
+
+func fakeLookup(w http.ResponseWriter, r *http.Request, store map[int64]map[string]any) (map[string]any, bool) {
+	id, ok := fakePathID(w, r, store)
+	if !ok {
+		return nil, false
+	}
+	return store[id], true
+}
+
+func fakeSortedIDs(store map[int64]map[string]any) []int64 {
+	ids := make([]int64, 0, len(store))
+	for id := range store {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// fakeCopy returns a deep copy of a stored object so responses can be
+// adjusted without touching server state.
+func fakeCopy(object map[string]any) map[string]any {
+	var buf bytes.Buffer
+	_ = json.NewEncoder(&buf).Encode(object)
+	decoder := json.NewDecoder(&buf)
+	decoder.UseNumber()
+	var copied map[string]any
+	_ = decoder.Decode(&copied)
+	return copied
+}
+
+// fakeWithout removes id from a JSON array of numbers.
+func fakeWithout(values any, id int64) any {
+	list, ok := values.([]any)
+	if !ok {
+		return values
+	}
+	kept := make([]any, 0, len(list))
+	for _, value := range list {
+		if value.(json.Number).String() != strconv.FormatInt(id, 10) {
+			kept = append(kept, value)
+		}
+	}
+	return kept
+}
+
+func fakeQueryInt(r *http.Request, name string, fallback int) int {
+	value, err := strconv.Atoi(r.URL.Query().Get(name))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+// fakePage applies 1-based page/limit query parameters.
+func fakePage(objects []map[string]any, r *http.Request, pageParam string) []map[string]any {
+	query := r.URL.Query()
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		return objects
+	}
+	page, err := strconv.Atoi(query.Get(pageParam))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	start := min((page-1)*limit, len(objects))
+	end := min(start+limit, len(objects))
+	return objects[start:end]
+}
+
+// fakeFilter applies the JSON "filters" query parameter used by the
+// deployments and private apps APIs. Each key must match the object's field
+// exactly; empty keys are ignored.
+func fakeFilter(objects []map[string]any, r *http.Request) []map[string]any {
+	raw := r.URL.Query().Get("filters")
+	if raw == "" {
+		return objects
+	}
+	if unescaped, err := url.QueryUnescape(raw); err == nil {
+		raw = unescaped
+	}
+
+	var filters map[string]any
+	if err := json.Unmarshal([]byte(raw), &filters); err != nil {
+		return objects
+	}
+
+	matches := make([]map[string]any, 0, len(objects))
+	for _, object := range objects {
+		matched := true
+		for key, want := range filters {
+			if key == "" {
+				continue
+			}
+			if fmt.Sprint(object[key]) != fmt.Sprint(want) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			matches = append(matches, object)
+		}
+	}
+	return matches
+}
@@ -0,0 +1,447 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	// defaultAPIEndpoint is the Secure Access API host used when no endpoint is configured
+	defaultAPIEndpoint = "api.sse.cisco.com"
+
+	// Retry defaults, overridable with the max_retries and retry_max_wait provider attributes
+	defaultMaxRetries   = 10
+	defaultRetryMaxWait = 30 * time.Second
+	defaultRetryMinWait = time.Second
+
+	// childOrgHeader asks the token endpoint for a token scoped to a child
+	// organization of the organization that owns the API key
+	childOrgHeader = "X-Umbrella-OrgId"
+)
+
+// retryConfig controls how API requests are retried
+type retryConfig struct {
+	MaxRetries int
+	MinWait    time.Duration
+	MaxWait    time.Duration
+}
+
+// defaultRetryConfig returns the retry settings used when the provider
+// configuration does not override them
+func defaultRetryConfig() retryConfig {
+	return retryConfig{
+		MaxRetries: defaultMaxRetries,
+		MinWait:    defaultRetryMinWait,
+		MaxWait:    defaultRetryMaxWait,
+	}
+}
+
+// retryTransport is an http.RoundTripper shared by every API client. It
+// retries requests that were throttled (429), waiting for the duration the
+// API asks for in Retry-After or otherwise backing off exponentially with
+// jitter. Idempotent requests are also retried when they conflicted with a
+// concurrent change (409), failed on the server side (5xx) or hit a
+// connection error; POST and PATCH requests only when they never reached the
+// API, since the API may have applied them. Retries stop early when the
+// request context would expire before the next attempt.
+type retryTransport struct {
+	base   http.RoundTripper
+	config retryConfig
+}
+
+// newRetryTransport wraps base, or http.DefaultTransport when base is nil
+func newRetryTransport(base http.RoundTripper, config retryConfig) *retryTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &retryTransport{base: base, config: config}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	// Buffer the body once so it can be replayed on every attempt
+	getBody := req.GetBody
+	if req.Body != nil && req.Body != http.NoBody && getBody == nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to buffer request body: %w", err)
+		}
+		getBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req.Clone(ctx)
+		if getBody != nil {
+			body, err := getBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			attemptReq.Body = body
+		}
+
+		// Record whether the request left the client, a POST that never did
+		// is safe to send again
+		var sent atomic.Bool
+		attemptReq = attemptReq.WithContext(httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+			WroteHeaders: func() { sent.Store(true) },
+		}))
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if !shouldRetryRequest(ctx, req.Method, sent.Load(), resp, err) || attempt >= t.config.MaxRetries {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			tflog.Debug(ctx, "Not retrying API request, context deadline is too close", map[string]interface{}{
+				"method": req.Method,
+				"url":    req.URL.String(),
+				"wait":   wait.String(),
+			})
+			return resp, err
+		}
+
+		fields := map[string]interface{}{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			// Release the connection before the next attempt
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		tflog.Debug(ctx, "Retrying API request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetryRequest reports whether an attempt failed in a way that another
+// attempt may fix. sent reports whether the request was written to the
+// connection; only then may a non-idempotent request have been applied.
+func shouldRetryRequest(ctx context.Context, method string, sent bool, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		// OAuth token errors already went through the token client's own
+		// retries
+		var tokenErr *oauth2.RetrieveError
+		if errors.As(err, &tokenErr) {
+			return false
+		}
+		return !sent || isIdempotentMethod(method)
+	}
+	// The server rejected throttled and conflicting requests without
+	// applying them, so they are retried whatever their method
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode == http.StatusConflict:
+		return true
+	case !isIdempotentMethod(method):
+		return false
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		return true
+	}
+	return false
+}
+
+// isIdempotentMethod reports whether sending a request with method twice has
+// the same effect as sending it once
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before the attempt following attempt. A
+// Retry-After header takes precedence over the exponential backoff; both are
+// capped at MaxWait.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if wait, ok := retryAfter(resp); ok {
+		return min(wait, t.config.MaxWait)
+	}
+
+	wait := t.config.MaxWait
+	if attempt < 32 {
+		wait = min(t.config.MinWait<<attempt, t.config.MaxWait)
+	}
+	if wait <= 0 {
+		return 0
+	}
+	// Equal jitter: half the backoff plus a random share of the other half
+	half := wait / 2
+	return half + rand.N(wait-half+1)
+}
+
+// retryAfter parses the Retry-After header, given in seconds or as an HTTP date
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// rateLimiter is a token bucket shared by every API request made through one
+// provider instance. Tokens refill at rate per second up to burst; a request
+// that finds the bucket empty reserves the next token and waits for it.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns a limiter that starts with a full bucket, or nil
+// when rate is not positive, which disables limiting
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	burst = max(burst, 1)
+	return &rateLimiter{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// reserve takes a token and returns how long the caller must wait before
+// using it
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token that was not used
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = min(l.burst, l.tokens+1)
+}
+
+// Wait blocks until a token is available. It fails without waiting when the
+// token would only become available after the context deadline.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	wait := l.reserve()
+	if wait == 0 {
+		return nil
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		l.cancel()
+		return fmt.Errorf("rate limit of %g requests per second would exceed the context deadline", l.rate)
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitTransport is an http.RoundTripper that waits for the shared rate
+// limiter before every request, including retries
+type rateLimitTransport struct {
+	base    http.RoundTripper
+	limiter *rateLimiter
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return t.base.RoundTrip(req)
+}
+
+// newAPITransport layers the retry and rate limit transports over base. Each
+// retry attempt waits for the rate limiter; limiter may be nil.
+func newAPITransport(base http.RoundTripper, retry retryConfig, limiter *rateLimiter) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if limiter != nil {
+		base = &rateLimitTransport{base: base, limiter: limiter}
+	}
+	return newRetryTransport(base, retry)
+}
+
+// childOrgTransport is an http.RoundTripper for token requests that exchanges
+// parent organization credentials for a token of the child organization orgID
+type childOrgTransport struct {
+	base  http.RoundTripper
+	orgID string
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *childOrgTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set(childOrgHeader, t.orgID)
+	return t.base.RoundTrip(req)
+}
+
+// clientCredentialsSource requests a new token on every call. Unlike
+// clientcredentials.Config.TokenSource it does not reuse tokens itself, so a
+// token dropped by cachingTokenSource is never handed out again.
+type clientCredentialsSource struct {
+	ctx    context.Context
+	config *clientcredentials.Config
+}
+
+// Token implements oauth2.TokenSource
+func (s *clientCredentialsSource) Token() (*oauth2.Token, error) {
+	return s.config.Token(s.ctx)
+}
+
+// rejectedTokenTransport drops the shared token when the API rejects it with
+// 401 Unauthorized, for example after it was revoked before its expiry, and
+// sends the request once more with a new token. Requests whose body cannot be
+// replayed are not sent again; the next request gets the new token.
+type rejectedTokenTransport struct {
+	base   http.RoundTripper
+	source *cachingTokenSource
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *rejectedTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	t.source.invalidate(strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "))
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+	token, tokenErr := t.source.Token()
+	if tokenErr != nil {
+		return resp, nil
+	}
+
+	retryReq := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, bodyErr := req.GetBody()
+		if bodyErr != nil {
+			return resp, nil
+		}
+		retryReq.Body = body
+	}
+	token.SetAuthHeader(retryReq)
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	return t.base.RoundTrip(retryReq)
+}
+
+// apiClientConfig holds the resolved provider settings used to build the
+// shared API client
+type apiClientConfig struct {
+	KeyID       string
+	KeySecret   string
+	APIEndpoint string
+	// Region is empty for a custom endpoint; it is only used to explain
+	// rejected credentials
+	Region string
+	// ChildOrgID, when set, requests tokens for that child organization
+	ChildOrgID string
+	// TokenCachePath, when set, is the directory tokens are persisted in
+	TokenCachePath string
+	// Transport carries every request, token requests included; it defaults
+	// to http.DefaultTransport
+	Transport http.RoundTripper
+	Retry     retryConfig
+	Limiter   *rateLimiter
+}
+
+// newAPIHTTPClient returns the HTTP client shared by every API client: OAuth2
+// client credentials authentication wrapped in the retry and rate limit
+// transports. Token requests are retried with the same policy but are not
+// rate limited. The token itself is shared with every other provider
+// instance in the process that uses the same credentials.
+func newAPIHTTPClient(config apiClientConfig) *http.Client {
+	if config.Transport == nil {
+		config.Transport = http.DefaultTransport
+	}
+	var tokenTransport http.RoundTripper = newRetryTransport(config.Transport, config.Retry)
+	if config.ChildOrgID != "" {
+		tokenTransport = &childOrgTransport{base: tokenTransport, orgID: config.ChildOrgID}
+	}
+	tokenClient := &http.Client{Transport: tokenTransport}
+
+	authConfig := &clientcredentials.Config{
+		ClientID:     config.KeyID,
+		ClientSecret: config.KeySecret,
+		TokenURL:     fmt.Sprintf("https://%s/auth/v2/token", config.APIEndpoint),
+	}
+
+	// Use context.Background() so the token source outlives the provider
+	// Configure call that creates it
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, tokenClient)
+	key := tokenCacheKey(config.KeyID, config.KeySecret, config.APIEndpoint, config.ChildOrgID)
+	tokenSource := sharedTokenSource(key, config.TokenCachePath, &clientCredentialsSource{ctx: ctx, config: authConfig})
+
+	oauthTransport := &oauth2.Transport{
+		Source: tokenSource,
+		Base:   &rejectedTokenTransport{base: config.Transport, source: tokenSource},
+	}
+	transport := &regionHintTransport{base: oauthTransport, endpoint: config.APIEndpoint, region: config.Region}
+	return &http.Client{Transport: newAPITransport(transport, config.Retry, config.Limiter)}
+}
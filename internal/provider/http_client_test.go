@@ -0,0 +1,484 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/oauth2"
+)
+
+// testRetryConfig keeps backoff short so retry tests run quickly
+func testRetryConfig(maxRetries int) retryConfig {
+	return retryConfig{MaxRetries: maxRetries, MinWait: time.Millisecond, MaxWait: 5 * time.Millisecond}
+}
+
+// newRetryTestServer responds with statuses in order, repeating the last one,
+// and records every request body it receives
+func newRetryTestServer(t *testing.T, header http.Header, statuses ...int) (*httptest.Server, *int32, *[]string) {
+	t.Helper()
+	var calls int32
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&calls, 1))
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(statuses[min(n, len(statuses))-1])
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls, &bodies
+}
+
+func TestRetryTransport_retriesRetryableStatuses(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusConflict, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			server, calls, bodies := newRetryTestServer(t, nil, status, status, http.StatusOK)
+			client := &http.Client{Transport: newRetryTransport(nil, testRetryConfig(5))}
+
+			req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"name":"rule"}`))
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("status = %d, want 200", resp.StatusCode)
+			}
+			if *calls != 3 {
+				t.Errorf("calls = %d, want 3", *calls)
+			}
+			for i, body := range *bodies {
+				if body != `{"name":"rule"}` {
+					t.Errorf("attempt %d sent body %q, want the original body", i+1, body)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryTransport_doesNotRetryClientErrors(t *testing.T) {
+	for _, status := range []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound, http.StatusNotImplemented} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			server, calls, _ := newRetryTestServer(t, nil, status)
+			client := &http.Client{Transport: newRetryTransport(nil, testRetryConfig(5))}
+
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != status || *calls != 1 {
+				t.Errorf("status = %d after %d calls, want %d after 1 call", resp.StatusCode, *calls, status)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_postRetriesOnlyRejections(t *testing.T) {
+	for _, status := range []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			server, calls, _ := newRetryTestServer(t, nil, status, http.StatusOK)
+			client := &http.Client{Transport: newRetryTransport(nil, testRetryConfig(5))}
+
+			// The API may have created the object before failing, a retry
+			// would create it twice
+			resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"rule"}`))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != status || *calls != 1 {
+				t.Errorf("status = %d after %d calls, want %d after 1 call", resp.StatusCode, *calls, status)
+			}
+		})
+	}
+
+	// Throttled and conflicting requests were rejected without being applied
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusConflict} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			server, calls, bodies := newRetryTestServer(t, nil, status, http.StatusOK)
+			client := &http.Client{Transport: newRetryTransport(nil, testRetryConfig(5))}
+			resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"rule"}`))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK || *calls != 2 || (*bodies)[1] != `{"name":"rule"}` {
+				t.Errorf("status = %d after %d calls with bodies %q, want 200 after 2 calls", resp.StatusCode, *calls, *bodies)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_connectionErrors(t *testing.T) {
+	// A closed server refuses connections, so requests never reach it
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	var attempts int32
+	counting := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&attempts, 1)
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	client := &http.Client{Transport: newRetryTransport(counting, testRetryConfig(2))}
+
+	if _, err := client.Post(closed.URL, "application/json", strings.NewReader(`{}`)); err == nil {
+		t.Fatal("expected a connection error")
+	}
+	if attempts != 3 {
+		t.Errorf("POST that was never sent: attempts = %d, want 3", attempts)
+	}
+
+	// A connection dropped after the request was written may have been
+	// applied; only idempotent requests are sent again
+	dropping := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.ReadAll(r.Body)
+		conn, _, _ := w.(http.Hijacker).Hijack()
+		conn.Close()
+	}))
+	t.Cleanup(dropping.Close)
+
+	for method, want := range map[string]int32{http.MethodPost: 1, http.MethodPut: 3} {
+		attempts = 0
+		req, _ := http.NewRequest(method, dropping.URL, strings.NewReader(`{}`))
+		if _, err := client.Do(req); err == nil {
+			t.Fatalf("%s: expected a connection error", method)
+		}
+		if attempts != want {
+			t.Errorf("%s dropped after sending: attempts = %d, want %d", method, attempts, want)
+		}
+	}
+}
+
+func TestRetryTransport_stopsAfterMaxRetries(t *testing.T) {
+	server, calls, _ := newRetryTestServer(t, nil, http.StatusServiceUnavailable)
+	client := &http.Client{Transport: newRetryTransport(nil, testRetryConfig(2))}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want the last response (503)", resp.StatusCode)
+	}
+	if *calls != 3 {
+		t.Errorf("calls = %d, want 3 (1 attempt + 2 retries)", *calls)
+	}
+}
+
+func TestRetryTransport_stopsBeforeContextDeadline(t *testing.T) {
+	server, calls, _ := newRetryTestServer(t, http.Header{"Retry-After": []string{"10"}}, http.StatusTooManyRequests)
+	config := testRetryConfig(5)
+	config.MaxWait = time.Minute
+	client := &http.Client{Transport: newRetryTransport(nil, config)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests || *calls != 1 {
+		t.Errorf("status = %d after %d calls, want 429 after 1 call", resp.StatusCode, *calls)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("waited %s for a retry that could not finish before the deadline", elapsed)
+	}
+}
+
+func TestRetryTransport_backoff(t *testing.T) {
+	transport := newRetryTransport(nil, retryConfig{MaxRetries: 10, MinWait: time.Second, MaxWait: 30 * time.Second})
+
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second} {
+		for i := 0; i < 20; i++ {
+			wait := transport.backoff(attempt, nil)
+			if wait < want/2 || wait > want {
+				t.Fatalf("attempt %d: backoff %s outside [%s, %s]", attempt, wait, want/2, want)
+			}
+		}
+	}
+
+	// Retry-After takes precedence and is capped at MaxWait
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if wait := transport.backoff(0, resp); wait != 3*time.Second {
+		t.Errorf("Retry-After backoff = %s, want 3s", wait)
+	}
+	resp.Header.Set("Retry-After", "120")
+	if wait := transport.backoff(0, resp); wait != 30*time.Second {
+		t.Errorf("Retry-After backoff = %s, want it capped at 30s", wait)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{value: "", wantOK: false},
+		{value: "5", want: 5 * time.Second, wantOK: true},
+		{value: "0", want: 0, wantOK: true},
+		{value: "-1", wantOK: false},
+		{value: "soon", wantOK: false},
+		{value: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), want: 0, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		resp := &http.Response{Header: http.Header{}}
+		if tt.value != "" {
+			resp.Header.Set("Retry-After", tt.value)
+		}
+		got, ok := retryAfter(resp)
+		if ok != tt.wantOK || got != tt.want {
+			t.Errorf("retryAfter(%q) = %s, %t, want %s, %t", tt.value, got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	// An HTTP date in the future yields the time remaining until then
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)}}}
+	if got, ok := retryAfter(resp); !ok || got <= 50*time.Second || got > time.Minute {
+		t.Errorf("retryAfter(date) = %s, %t, want about 1m", got, ok)
+	}
+}
+
+func TestResolveRetryConfig(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv(envMaxRetries, "")
+		t.Setenv(envRetryMaxWait, "")
+		retry, diags := resolveRetryConfig(ciscosecureaccessProviderModel{MaxRetries: types.Int64Null(), RetryMaxWait: types.Int64Null()})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if retry != defaultRetryConfig() {
+			t.Errorf("retry = %+v, want defaults %+v", retry, defaultRetryConfig())
+		}
+	})
+
+	t.Run("environment", func(t *testing.T) {
+		t.Setenv(envMaxRetries, "3")
+		t.Setenv(envRetryMaxWait, "7")
+		retry, diags := resolveRetryConfig(ciscosecureaccessProviderModel{MaxRetries: types.Int64Null(), RetryMaxWait: types.Int64Null()})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if retry.MaxRetries != 3 || retry.MaxWait != 7*time.Second {
+			t.Errorf("retry = %+v, want 3 retries waiting at most 7s", retry)
+		}
+	})
+
+	t.Run("configuration overrides environment", func(t *testing.T) {
+		t.Setenv(envMaxRetries, "3")
+		t.Setenv(envRetryMaxWait, "7")
+		retry, diags := resolveRetryConfig(ciscosecureaccessProviderModel{MaxRetries: types.Int64Value(0), RetryMaxWait: types.Int64Value(60)})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if retry.MaxRetries != 0 || retry.MaxWait != time.Minute {
+			t.Errorf("retry = %+v, want 0 retries waiting at most 1m", retry)
+		}
+	})
+
+	t.Run("invalid environment", func(t *testing.T) {
+		t.Setenv(envMaxRetries, "many")
+		t.Setenv(envRetryMaxWait, "0")
+		_, diags := resolveRetryConfig(ciscosecureaccessProviderModel{MaxRetries: types.Int64Null(), RetryMaxWait: types.Int64Null()})
+		if diags.ErrorsCount() != 2 {
+			t.Errorf("got %d errors, want 2: %v", diags.ErrorsCount(), diags)
+		}
+	})
+}
+
+func TestRateLimiter_burstThenRate(t *testing.T) {
+	limiter := newRateLimiter(20, 3)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 20*time.Millisecond {
+		t.Errorf("burst of 3 took %s, want no wait", elapsed)
+	}
+
+	// The bucket is empty, so each further request waits 1/20s
+	start = time.Now()
+	for i := 0; i < 2; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("2 requests over the limit took %s, want at least 100ms", elapsed)
+	}
+}
+
+func TestRateLimiter_contextDeadline(t *testing.T) {
+	limiter := newRateLimiter(1, 1)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := limiter.Wait(ctx); err == nil {
+		t.Fatal("expected an error when the next token is due after the deadline")
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("waited %s for a token that could not arrive before the deadline", elapsed)
+	}
+}
+
+func TestNewRateLimiter_disabled(t *testing.T) {
+	if limiter := newRateLimiter(0, 10); limiter != nil {
+		t.Errorf("newRateLimiter(0, 10) = %+v, want nil", limiter)
+	}
+}
+
+func TestAPITransport_rateLimitsRetries(t *testing.T) {
+	server, calls, _ := newRetryTestServer(t, nil, http.StatusTooManyRequests, http.StatusOK)
+	client := &http.Client{Transport: newAPITransport(nil, testRetryConfig(3), newRateLimiter(10, 1))}
+
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || *calls != 2 {
+		t.Errorf("status = %d after %d calls, want 200 after 2 calls", resp.StatusCode, *calls)
+	}
+	// The retry needs a second token, which arrives 100ms after the first
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("retry was sent after %s, want it rate limited to at least 100ms", elapsed)
+	}
+}
+
+func TestResolveRateLimiter(t *testing.T) {
+	unset := ciscosecureaccessProviderModel{RequestsPerSecond: types.Float64Null(), Burst: types.Int64Null()}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Setenv(envRequestsPerSecond, "")
+		t.Setenv(envBurst, "")
+		limiter, diags := resolveRateLimiter(unset)
+		if diags.HasError() || limiter != nil {
+			t.Errorf("got %+v, %v, want no limiter", limiter, diags)
+		}
+	})
+
+	t.Run("burst defaults to rate", func(t *testing.T) {
+		t.Setenv(envRequestsPerSecond, "2.5")
+		t.Setenv(envBurst, "")
+		limiter, diags := resolveRateLimiter(unset)
+		if diags.HasError() || limiter == nil {
+			t.Fatalf("got %+v, %v, want a limiter", limiter, diags)
+		}
+		if limiter.rate != 2.5 || limiter.burst != 3 {
+			t.Errorf("rate = %g, burst = %g, want 2.5 and 3", limiter.rate, limiter.burst)
+		}
+	})
+
+	t.Run("configuration overrides environment", func(t *testing.T) {
+		t.Setenv(envRequestsPerSecond, "2.5")
+		t.Setenv(envBurst, "1")
+		limiter, diags := resolveRateLimiter(ciscosecureaccessProviderModel{RequestsPerSecond: types.Float64Value(10), Burst: types.Int64Value(20)})
+		if diags.HasError() || limiter == nil {
+			t.Fatalf("got %+v, %v, want a limiter", limiter, diags)
+		}
+		if limiter.rate != 10 || limiter.burst != 20 {
+			t.Errorf("rate = %g, burst = %g, want 10 and 20", limiter.rate, limiter.burst)
+		}
+	})
+
+	t.Run("invalid environment", func(t *testing.T) {
+		t.Setenv(envRequestsPerSecond, "fast")
+		t.Setenv(envBurst, "")
+		if _, diags := resolveRateLimiter(unset); !diags.HasError() {
+			t.Error("expected an error for an invalid rate")
+		}
+	})
+}
+
+func TestChildOrgTransport_setsOrgHeader(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get(childOrgHeader)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("grant_type=client_credentials"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: &childOrgTransport{base: http.DefaultTransport, orgID: "8000002"}}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if got != "8000002" {
+		t.Errorf("%s = %q, want 8000002", childOrgHeader, got)
+	}
+	if req.Header.Get(childOrgHeader) != "" {
+		t.Error("childOrgTransport modified the caller's request")
+	}
+}
+
+func TestRejectedTokenTransport_retriesWithNewToken(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	base := &countingTokenSource{lifetime: time.Hour}
+	source := &cachingTokenSource{store: &tokenStore{}, base: base}
+	client := &http.Client{Transport: &oauth2.Transport{
+		Source: source,
+		Base:   &rejectedTokenTransport{base: http.DefaultTransport, source: source},
+	}}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"a"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", resp.StatusCode)
+	}
+	if base.calls != 2 || len(bodies) != 2 || bodies[1] != `{"name":"a"}` {
+		t.Errorf("requested %d tokens and sent %q, want 2 tokens and the body sent twice", base.calls, bodies)
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// Number of objects to request per page when looking up an import name
+const importLookupPageLimit = 100
+
+// resolveImportID returns the numeric ID for an import ID that is either a
+// numeric object ID or, as a convenience, the exact name of the object.
+// findByName returns the IDs of all objects named name.
+func resolveImportID(ctx context.Context, importID, objectType string, findByName func(ctx context.Context, name string) ([]int64, error)) (int64, error) {
+	if id, err := strconv.ParseInt(importID, 10, 64); err == nil {
+		return id, nil
+	}
+
+	ids, err := findByName(ctx, importID)
+	if err != nil {
+		return 0, fmt.Errorf("could not look up %s named %q: %w", objectType, importID, err)
+	}
+
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("no %s named %q was found", objectType, importID)
+	case 1:
+		return ids[0], nil
+	default:
+		return 0, fmt.Errorf("%d %ss are named %q, import by ID instead", len(ids), objectType, importID)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResolveImportID_numeric(t *testing.T) {
+	findByName := func(context.Context, string) ([]int64, error) {
+		t.Fatal("numeric import IDs must not be looked up by name")
+		return nil, nil
+	}
+
+	id, err := resolveImportID(context.Background(), "12345", "widget", findByName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != 12345 {
+		t.Errorf("id = %d, want 12345", id)
+	}
+}
+
+func TestResolveImportID_name(t *testing.T) {
+	tests := []struct {
+		name    string
+		ids     []int64
+		lookErr error
+		wantID  int64
+		wantErr string
+	}{
+		{name: "single match", ids: []int64{42}, wantID: 42},
+		{name: "no match", wantErr: `no widget named "branch 1" was found`},
+		{name: "multiple matches", ids: []int64{42, 43}, wantErr: `2 widgets are named "branch 1", import by ID instead`},
+		{name: "lookup failure", lookErr: errors.New("boom"), wantErr: `could not look up widget named "branch 1": boom`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findByName := func(_ context.Context, name string) ([]int64, error) {
+				if name != "branch 1" {
+					t.Errorf("looked up name %q, want %q", name, "branch 1")
+				}
+				return tt.ids, tt.lookErr
+			}
+
+			id, err := resolveImportID(context.Background(), "branch 1", "widget", findByName)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if id != tt.wantID {
+				t.Errorf("id = %d, want %d", id, tt.wantID)
+			}
+		})
+	}
+}
+
+// testAccImportStateIDByName returns an ImportStateIdFunc that imports the
+// named resource by its name attribute instead of its ID
+func testAccImportStateIDByName(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		name := rs.Primary.Attributes["name"]
+		if strings.TrimSpace(name) == "" {
+			return "", fmt.Errorf("resource %s has no name", resourceName)
+		}
+		return name, nil
+	}
+}
@@ -6,26 +6,55 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Environment variable names
 const (
-	envKeyID     = "CISCOSECUREACCESS_KEY_ID"
-	envKeySecret = "CISCOSECUREACCESS_KEY_SECRET"
+	envKeyID              = "CISCOSECUREACCESS_KEY_ID"
+	envKeySecret          = "CISCOSECUREACCESS_KEY_SECRET"
+	envKeySecretFile      = "CISCOSECUREACCESS_KEY_SECRET_FILE"
+	envProfile            = "CISCOSECUREACCESS_PROFILE"
+	envCredentialsFile    = "CISCOSECUREACCESS_CREDENTIALS_FILE"
+	envCredentialProcess  = "CISCOSECUREACCESS_CREDENTIAL_PROCESS"
+	envAPIEndpoint        = "CISCOSECUREACCESS_API_ENDPOINT"
+	envMaxRetries         = "CISCOSECUREACCESS_MAX_RETRIES"
+	envRetryMaxWait       = "CISCOSECUREACCESS_RETRY_MAX_WAIT"
+	envRequestsPerSecond  = "CISCOSECUREACCESS_REQUESTS_PER_SECOND"
+	envBurst              = "CISCOSECUREACCESS_BURST"
+	envChildOrgID         = "CISCOSECUREACCESS_CHILD_ORG_ID"
+	envRegion             = "CISCOSECUREACCESS_REGION"
+	envTokenCachePath     = "CISCOSECUREACCESS_TOKEN_CACHE_PATH"
+	envProxyURL           = "CISCOSECUREACCESS_PROXY_URL"
+	envCACertFile         = "CISCOSECUREACCESS_CA_CERT_FILE"
+	envInsecureSkipVerify = "CISCOSECUREACCESS_INSECURE_SKIP_VERIFY"
+	envClientCertFile     = "CISCOSECUREACCESS_CLIENT_CERT_FILE"
+	envClientKeyFile      = "CISCOSECUREACCESS_CLIENT_KEY_FILE"
 )
 
 var (
-	_ provider.Provider = &ciscosecureaccessProvider{}
+	_ provider.Provider                       = &ciscosecureaccessProvider{}
+	_ provider.ProviderWithEphemeralResources = &ciscosecureaccessProvider{}
 )
 
 type ciscosecureaccessProvider struct {
@@ -37,9 +66,28 @@ type ciscosecureaccessProvider struct {
 }
 
 type ciscosecureaccessProviderModel struct {
-	APIEndpoint types.String `tfsdk:"api_endpoint"`
-	KeyID       types.String `tfsdk:"key_id"`
-	KeySecret   types.String `tfsdk:"key_secret"`
+	APIEndpoint        types.String  `tfsdk:"api_endpoint"`
+	KeyID              types.String  `tfsdk:"key_id"`
+	KeySecret          types.String  `tfsdk:"key_secret"`
+	KeySecretFile      types.String  `tfsdk:"key_secret_file"`
+	Profile            types.String  `tfsdk:"profile"`
+	CredentialsFile    types.String  `tfsdk:"credentials_file"`
+	CredentialProcess  types.String  `tfsdk:"credential_process"`
+	MaxRetries         types.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait       types.Int64   `tfsdk:"retry_max_wait"`
+	RequestsPerSecond  types.Float64 `tfsdk:"requests_per_second"`
+	Burst              types.Int64   `tfsdk:"burst"`
+	ChildOrgID         types.Int64   `tfsdk:"child_org_id"`
+	Region             types.String  `tfsdk:"region"`
+	TokenCachePath     types.String  `tfsdk:"token_cache_path"`
+	ProxyURL           types.String  `tfsdk:"proxy_url"`
+	CACertPEM          types.String  `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String  `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool    `tfsdk:"insecure_skip_verify"`
+	ClientCertPEM      types.String  `tfsdk:"client_cert_pem"`
+	ClientCertFile     types.String  `tfsdk:"client_cert_file"`
+	ClientKeyPEM       types.String  `tfsdk:"client_key_pem"`
+	ClientKeyFile      types.String  `tfsdk:"client_key_file"`
 }
 
 // New creates a new Cisco Secure Access provider instance
@@ -67,13 +115,138 @@ func (p *ciscosecureaccessProvider) Schema(_ context.Context, _ provider.SchemaR
 				Optional:    true,
 			},
 			"key_secret": schema.StringAttribute{
-				Description: "Cisco Secure Access API Key Secret. Can also be set via the " + envKeySecret + " environment variable.",
+				Description: "Cisco Secure Access API Key Secret. Conflicts with key_secret_file. Can also be set via the " + envKeySecret + " environment variable.",
 				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("key_secret_file")),
+				},
+			},
+			"key_secret_file": schema.StringAttribute{
+				Description: "Path to a file holding the Cisco Secure Access API Key Secret, so the secret does not appear in configuration. " +
+					"Surrounding whitespace is ignored. Can also be set via the " + envKeySecretFile + " environment variable.",
+				Optional: true,
+			},
+			"profile": schema.StringAttribute{
+				Description: "Name of the credentials file profile holding the API key. Takes precedence over the " + envKeyID + " and " + envKeySecret + " environment variables. " +
+					"Defaults to the " + defaultProfile + " profile, which is only used when the key is not set otherwise. Can also be set via the " + envProfile + " environment variable.",
+				Optional: true,
+			},
+			"credentials_file": schema.StringAttribute{
+				Description: "Path to the shared credentials file. Defaults to ~/.ciscosecureaccess/credentials. Can also be set via the " + envCredentialsFile + " environment variable.",
+				Optional:    true,
+			},
+			"credential_process": schema.StringAttribute{
+				Description: "Command run through the system shell that prints the API key as a JSON object with key_id and key_secret, for example to read it from a secret manager. " +
+					"Takes precedence over profiles and environment variables. Can also be set via the " + envCredentialProcess + " environment variable.",
+				Optional: true,
 			},
 			"api_endpoint": schema.StringAttribute{
-				Description: "Cisco Secure Access API endpoint. Optional custom endpoint for the API.",
+				Description: "Cisco Secure Access API endpoint. Optional custom endpoint for the API, given as a host name with an optional port and without a scheme. " +
+					"Conflicts with region. Can also be set via the " + envAPIEndpoint + " environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("region")),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: fmt.Sprintf("Region of the Cisco Secure Access organization, one of: %s. Selects the API endpoint used for authentication and every API. "+
+					"Defaults to %s. Conflicts with api_endpoint. Can also be set via the %s environment variable.", strings.Join(regionNames(), ", "), defaultRegion, envRegion),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(regionNames()...),
+				},
+			},
+			"child_org_id": schema.Int64Attribute{
+				Description: "ID of a child organization to manage with the API key of its parent (Managed Service Provider) organization. " +
+					"Tokens are exchanged for the child organization, so every resource and data source of this provider instance acts on it. " +
+					"Use one provider alias per child organization. Can also be set via the " + envChildOrgID + " environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"token_cache_path": schema.StringAttribute{
+				Description: "Directory in which OAuth tokens are cached between Terraform runs, so runs started before a token expires skip authentication. " +
+					"Tokens are always shared in memory by provider instances that use the same API key. Cache files hold bearer tokens and are only readable by the current user. " +
+					"Can also be set via the " + envTokenCachePath + " environment variable.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the HTTP, HTTPS or SOCKS5 proxy used for every API request, such as http://proxy.example.com:3128. " +
+					"Defaults to the HTTPS_PROXY and NO_PROXY environment variables. Can also be set via the " + envProxyURL + " environment variable.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificates trusted in addition to the system roots, for example the CA of an inspecting proxy. Conflicts with ca_cert_file.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a file of PEM encoded CA certificates trusted in addition to the system roots. Conflicts with ca_cert_pem. " +
+					"Can also be set via the " + envCACertFile + " environment variable.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Disable verification of the API server certificate. This exposes the API key and all traffic to interception and " +
+					"is only meant for troubleshooting; trust the proxy CA with ca_cert_pem or ca_cert_file instead. Defaults to false. " +
+					"Can also be set via the " + envInsecureSkipVerify + " environment variable.",
+				Optional: true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded client certificate presented to a proxy or endpoint that requires mutual TLS. Requires client_key_pem or client_key_file. Conflicts with client_cert_file.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_cert_file")),
+				},
+			},
+			"client_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM encoded client certificate presented to a proxy or endpoint that requires mutual TLS. Conflicts with client_cert_pem. " +
+					"Can also be set via the " + envClientCertFile + " environment variable.",
+				Optional: true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate. Conflicts with client_key_file.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_key_file")),
+				},
+			},
+			"client_key_file": schema.StringAttribute{
+				Description: "Path to the PEM encoded private key of the client certificate. Conflicts with client_key_pem. " +
+					"Can also be set via the " + envClientKeyFile + " environment variable.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of times an API request is retried after a 429 or 409 response, or after a 5xx response or a connection error when repeating the request is safe. Defaults to %d. Can also be set via the %s environment variable.", defaultMaxRetries, envMaxRetries),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of seconds to wait between retries, including waits requested by the API with Retry-After. Defaults to %d. Can also be set via the %s environment variable.", int64(defaultRetryMaxWait/time.Second), envRetryMaxWait),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum average rate of API requests, shared by all resources and data sources of this provider instance. Retries count towards the limit. Requests are not rate limited when unset or 0. Can also be set via the " + envRequestsPerSecond + " environment variable.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"burst": schema.Int64Attribute{
+				Description: "Number of API requests that may be sent at once before requests_per_second applies. Defaults to requests_per_second rounded up. Can also be set via the " + envBurst + " environment variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 			},
 		},
 	}
@@ -108,12 +281,47 @@ func (p *ciscosecureaccessProvider) Configure(ctx context.Context, req provider.
 		)
 	}
 
+	for name, value := range map[string]types.String{
+		"key_secret_file":    config.KeySecretFile,
+		"profile":            config.Profile,
+		"credentials_file":   config.CredentialsFile,
+		"credential_process": config.CredentialProcess,
+	} {
+		if value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unknown Cisco Secure Access Credentials Source",
+				fmt.Sprintf("The provider cannot create the Cisco Secure Access API client as there is an unknown configuration value for %s.", name),
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Resolve configuration values
-	keyID, keySecret, apiEndpoint := validateAndResolveConfig(ctx, config)
+	keyID, keySecret, credentialDiags := resolveCredentials(ctx, config)
+	resp.Diagnostics.Append(credentialDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	apiEndpoint, region, endpointDiags := resolveAPIEndpoint(config)
+	resp.Diagnostics.Append(endpointDiags...)
+	retry, retryDiags := resolveRetryConfig(config)
+	resp.Diagnostics.Append(retryDiags...)
+	limiter, limiterDiags := resolveRateLimiter(config)
+	resp.Diagnostics.Append(limiterDiags...)
+	transportSettings, transportDiags := resolveTransportConfig(config)
+	resp.Diagnostics.Append(transportDiags...)
+	childOrgID, err := resolveInt64Setting(config.ChildOrgID, envChildOrgID)
+	if err != nil || (childOrgID != nil && *childOrgID < 1) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("child_org_id"),
+			"Invalid Child Organization ID",
+			fmt.Sprintf("child_org_id must be a positive organization ID, check the %s environment variable.", envChildOrgID),
+		)
+	}
 
 	// Validate required configuration
 	if keyID == "" {
@@ -121,7 +329,7 @@ func (p *ciscosecureaccessProvider) Configure(ctx context.Context, req provider.
 			path.Root("key_id"),
 			"Missing Cisco Secure Access API Key ID",
 			"The provider cannot create the Cisco Secure Access API client as there is a missing or empty value for the API Key ID. "+
-				"Set the key_id value in the provider configuration or use the "+envKeyID+" environment variable. "+
+				"Set the key_id value in the provider configuration, use the "+envKeyID+" environment variable, or configure a profile or credential_process. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -131,7 +339,7 @@ func (p *ciscosecureaccessProvider) Configure(ctx context.Context, req provider.
 			path.Root("key_secret"),
 			"Missing Cisco Secure Access API Key Secret",
 			"The provider cannot create the Cisco Secure Access API client as there is a missing or empty value for the API Key Secret. "+
-				"Set the key_secret value in the provider configuration or use the "+envKeySecret+" environment variable. "+
+				"Set the key_secret or key_secret_file value in the provider configuration, use the "+envKeySecret+" environment variable, or configure a profile or credential_process. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -140,17 +348,59 @@ func (p *ciscosecureaccessProvider) Configure(ctx context.Context, req provider.
 		return
 	}
 
+	if transportSettings.InsecureSkipVerify {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Certificate Verification Disabled",
+			"insecure_skip_verify is enabled, so the provider does not verify the certificate of the Cisco Secure Access API. "+
+				"Anyone able to intercept the connection can read the API key secret and tokens and modify API requests. "+
+				"Trust the CA of your proxy with ca_cert_pem or ca_cert_file and disable insecure_skip_verify.",
+		)
+		tflog.Warn(ctx, "TLS certificate verification of the Cisco Secure Access API is disabled")
+	}
+
+	baseTransport, err := newBaseTransport(transportSettings)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid TLS Configuration",
+			fmt.Sprintf("The provider cannot create the Cisco Secure Access API client: %s. Check the CA and client certificate settings.", err.Error()),
+		)
+		return
+	}
+
 	// Set up logging context with secure field masking
 	ctx = tflog.SetField(ctx, "ciscosecureaccess_key_id", keyID)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "ciscosecureaccess_key_secret")
+	ctx = tflog.SetField(ctx, "ciscosecureaccess_api_endpoint", apiEndpoint)
+	ctx = tflog.SetField(ctx, "ciscosecureaccess_region", region)
+	var childOrg string
+	if childOrgID != nil {
+		childOrg = strconv.FormatInt(*childOrgID, 10)
+		ctx = tflog.SetField(ctx, "ciscosecureaccess_child_org_id", childOrg)
+	}
 
 	tflog.Debug(ctx, "Creating Cisco Secure Access client")
 
+	// Every API client shares one HTTP client, so retries, backoff and the
+	// rate limit apply to all resources together
+	httpClient := newAPIHTTPClient(apiClientConfig{
+		KeyID:          keyID,
+		KeySecret:      keySecret,
+		APIEndpoint:    apiEndpoint,
+		Region:         region,
+		ChildOrgID:     childOrg,
+		TokenCachePath: resolveStringSetting(config.TokenCachePath, envTokenCachePath),
+		Transport:      baseTransport,
+		Retry:          retry,
+		Limiter:        limiter,
+	})
+
 	// Initialize client factory
 	p.clientFactory = &client.SSEClientFactory{
-		KeyId:       keyID,
-		KeySecret:   keySecret,
-		ApiEndpoint: apiEndpoint,
+		KeyId:         keyID,
+		KeySecret:     keySecret,
+		ApiEndpoint:   apiEndpoint,
+		SSEHttpClient: httpClient,
 	}
 
 	// Ensure clientFactory is not nil after initialization
@@ -162,31 +412,113 @@ func (p *ciscosecureaccessProvider) Configure(ctx context.Context, req provider.
 		return
 	}
 
-	// Make the client factory available during DataSource and Resource
-	// type Configure methods.
+	// Make the client factory available during DataSource, Resource and
+	// EphemeralResource type Configure methods.
 	resp.DataSourceData = p.clientFactory
 	resp.ResourceData = p.clientFactory
+	resp.EphemeralResourceData = p.clientFactory
 
 	tflog.Info(ctx, "Configured Cisco Secure Access client", map[string]any{"success": true})
 }
 
-// validateAndResolveConfig validates the provider configuration and resolves the final values
-func validateAndResolveConfig(ctx context.Context, config ciscosecureaccessProviderModel) (keyID, keySecret, apiEndpoint string) {
-	// Start with environment variables as defaults
-	keyID = os.Getenv(envKeyID)
-	keySecret = os.Getenv(envKeySecret)
-	apiEndpoint = config.APIEndpoint.ValueString()
+// resolveRetryConfig resolves max_retries and retry_max_wait from the
+// provider configuration, falling back to environment variables and defaults
+func resolveRetryConfig(config ciscosecureaccessProviderModel) (retryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	retry := defaultRetryConfig()
 
-	// Override with Terraform configuration if provided
-	if !config.KeyID.IsNull() && config.KeyID.ValueString() != "" {
-		keyID = config.KeyID.ValueString()
+	maxRetries, err := resolveInt64Setting(config.MaxRetries, envMaxRetries)
+	if err != nil || (maxRetries != nil && *maxRetries < 0) {
+		diags.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid Max Retries",
+			fmt.Sprintf("max_retries must be a non-negative integer, check the %s environment variable.", envMaxRetries),
+		)
+	} else if maxRetries != nil {
+		retry.MaxRetries = int(*maxRetries)
 	}
 
-	if !config.KeySecret.IsNull() && config.KeySecret.ValueString() != "" {
-		keySecret = config.KeySecret.ValueString()
+	maxWait, err := resolveInt64Setting(config.RetryMaxWait, envRetryMaxWait)
+	if err != nil || (maxWait != nil && *maxWait < 1) {
+		diags.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Invalid Retry Max Wait",
+			fmt.Sprintf("retry_max_wait must be a positive number of seconds, check the %s environment variable.", envRetryMaxWait),
+		)
+	} else if maxWait != nil {
+		retry.MaxWait = time.Duration(*maxWait) * time.Second
 	}
 
-	return keyID, keySecret, apiEndpoint
+	return retry, diags
+}
+
+// resolveRateLimiter builds the rate limiter from requests_per_second and
+// burst, falling back to environment variables. It returns nil when no rate
+// is configured.
+func resolveRateLimiter(config ciscosecureaccessProviderModel) (*rateLimiter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rate := 0.0
+	if !config.RequestsPerSecond.IsNull() && !config.RequestsPerSecond.IsUnknown() {
+		rate = config.RequestsPerSecond.ValueFloat64()
+	} else if env := os.Getenv(envRequestsPerSecond); env != "" {
+		v, err := strconv.ParseFloat(env, 64)
+		if err != nil || v < 0 {
+			diags.AddAttributeError(
+				path.Root("requests_per_second"),
+				"Invalid Requests Per Second",
+				fmt.Sprintf("requests_per_second must be a non-negative number, check the %s environment variable.", envRequestsPerSecond),
+			)
+			return nil, diags
+		}
+		rate = v
+	}
+
+	burst, err := resolveInt64Setting(config.Burst, envBurst)
+	if err != nil || (burst != nil && *burst < 1) {
+		diags.AddAttributeError(
+			path.Root("burst"),
+			"Invalid Burst",
+			fmt.Sprintf("burst must be a positive integer, check the %s environment variable.", envBurst),
+		)
+		return nil, diags
+	}
+
+	if rate == 0 {
+		return nil, diags
+	}
+	size := int(math.Ceil(rate))
+	if burst != nil {
+		size = int(*burst)
+	}
+	return newRateLimiter(rate, size), diags
+}
+
+// resolveInt64Setting returns the configured value, or the value of envVar
+// when the attribute is not set. It returns nil when neither is set.
+func resolveInt64Setting(value types.Int64, envVar string) (*int64, error) {
+	if !value.IsNull() && !value.IsUnknown() {
+		v := value.ValueInt64()
+		return &v, nil
+	}
+	env := os.Getenv(envVar)
+	if env == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseInt(env, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// resolveStringSetting returns the configured value, or the value of envVar
+// when the attribute is not set
+func resolveStringSetting(value types.String, envVar string) string {
+	if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+		return value.ValueString()
+	}
+	return os.Getenv(envVar)
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -196,6 +528,20 @@ func (p *ciscosecureaccessProvider) DataSources(_ context.Context) []func() data
 		NewIdentityDataSource,
 		NewGroupDataSource,
 		NewContentCategoryListDataSource,
+		NewAccessPoliciesDataSource,
+		NewChildOrgsDataSource,
+		NewNetworkTunnelGroupStateDataSource,
+		NewNetworkTunnelGroupConfigDataSource,
+		NewRegionsDataSource,
+		NewDatacentersDataSource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *ciscosecureaccessProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewNTGPresharedKeyEphemeralResource,
+		NewProvisioningKeyEphemeralResource,
 	}
 }
 
@@ -203,6 +549,7 @@ func (p *ciscosecureaccessProvider) DataSources(_ context.Context) []func() data
 func (p *ciscosecureaccessProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAccessPolicyResource,
+		NewAccessPolicyOrderResource,
 		NewDestinationListResource,
 		NewInternalDomainResource,
 		NewInternalNetworkResource,
@@ -212,7 +559,10 @@ func (p *ciscosecureaccessProvider) Resources(_ context.Context) []func() resour
 		NewNetworkTunnelGroupResource,
 		NewGlobalSettingsResource,
 		NewPrivateResourceResource,
+		NewPrivateResourceGroupResource,
 		NewResourceConnectorAgentResource,
+		NewResourceConnectorGroupResource,
+		NewResourceConnectorAgentsResource,
 		NewSiteResource,
 	}
 }
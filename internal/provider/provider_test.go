@@ -5,18 +5,79 @@
 package provider
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
 
 	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/require"
 )
 
 var testSSEClientFactory *client.SSEClientFactory
 var testAccCiscoSecureAccessProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"ciscosecureaccess": providerserver.NewProtocol6WithError(New("0.0.1")()),
+	"ciscosecureaccess": func() (tfprotov6.ProviderServer, error) {
+		return providerserver.NewProtocol6WithError(New("0.0.1")())()
+	},
+}
+
+// testFakeAPI is the in-process fake API the acceptance tests run against
+// when no real API credentials are configured.
+var testFakeAPI *fakeAPIServer
+
+// TestMain points the acceptance tests at the fake API unless
+// CISCOSECUREACCESS_KEY_ID and CISCOSECUREACCESS_KEY_SECRET are set, in
+// which case the tests run against the real Secure Access API. The provider
+// reaches the fake through its own HTTP client, with the endpoint and CA
+// bundle overridden.
+func TestMain(m *testing.M) {
+	_, hasKeyID := os.LookupEnv(envKeyID)
+	_, hasKeySecret := os.LookupEnv(envKeySecret)
+	if hasKeyID != hasKeySecret {
+		fmt.Fprintf(os.Stderr, "Set both %s and %s to run the acceptance tests against the real API, or neither to use the fake API.\n", envKeyID, envKeySecret)
+		os.Exit(1)
+	}
+
+	var caCertDir string
+	if !hasKeyID {
+		testFakeAPI = newFakeAPIServer()
+		var err error
+		if caCertDir, err = os.MkdirTemp("", "ciscosecureaccess-fake-api"); err != nil {
+			fmt.Fprintf(os.Stderr, "Creating CA bundle directory: %s\n", err)
+			os.Exit(1)
+		}
+		caCertFile := filepath.Join(caCertDir, "ca.pem")
+		if err := os.WriteFile(caCertFile, testFakeAPI.CACertPEM(), 0o600); err != nil {
+			fmt.Fprintf(os.Stderr, "Writing CA bundle: %s\n", err)
+			os.Exit(1)
+		}
+		os.Setenv(envKeyID, fakeAPIKeyID)
+		os.Setenv(envKeySecret, fakeAPIKeySecret)
+		os.Setenv(envAPIEndpoint, testFakeAPI.Endpoint())
+		os.Setenv(envCACertFile, caCertFile)
+
+		// Tests that would create billable or org-wide objects in a real
+		// organization are safe to run against the fake.
+		for _, envVar := range []string{"TF_ACC_NETWORK", testGlobalSettingsEnvVar} {
+			if os.Getenv(envVar) == "" {
+				os.Setenv(envVar, "true")
+			}
+		}
+		testRateLimitDisabled = true
+	}
+
+	code := m.Run()
+
+	if testFakeAPI != nil {
+		testFakeAPI.Close()
+		os.RemoveAll(caCertDir)
+	}
+	os.Exit(code)
 }
 
 func testAccPreCheck(t *testing.T) {
@@ -28,17 +89,102 @@ func ptrInt64(v int64) *int64   { return &v }
 func ptrBool(v bool) *bool      { return &v }
 
 func testClientFactory(t *testing.T) *client.SSEClientFactory {
-	if testSSEClientFactory == nil {
-		keyId, ok := os.LookupEnv("CISCOSECUREACCESS_KEY_ID")
-		require.True(t, ok, "missing CISCOSECUREACCESS_KEY_ID")
-		keySecret, ok := os.LookupEnv("CISCOSECUREACCESS_KEY_SECRET")
-		require.True(t, ok, "missing CISCOSECUREACCESS_KEY_SECRET")
+	_, ok := os.LookupEnv("CISCOSECUREACCESS_KEY_ID")
+	require.True(t, ok, "missing CISCOSECUREACCESS_KEY_ID")
+	_, ok = os.LookupEnv("CISCOSECUREACCESS_KEY_SECRET")
+	require.True(t, ok, "missing CISCOSECUREACCESS_KEY_SECRET")
 
+	return testAccClientFactory()
+}
+
+// testAccClientFactory returns a client factory for the API the acceptance
+// tests run against, for use in fixtures and destroy checks.
+func testAccClientFactory() *client.SSEClientFactory {
+	if testSSEClientFactory == nil {
 		testSSEClientFactory = &client.SSEClientFactory{
-			KeyId:     keyId,
-			KeySecret: keySecret,
+			KeyId:       os.Getenv("CISCOSECUREACCESS_KEY_ID"),
+			KeySecret:   os.Getenv("CISCOSECUREACCESS_KEY_SECRET"),
+			ApiEndpoint: os.Getenv(envAPIEndpoint),
+		}
+		if testFakeAPI != nil {
+			testSSEClientFactory.SSEHttpClient = testFakeAPI.HTTPClient()
 		}
 	}
 
 	return testSSEClientFactory
 }
+
+// testAccFixture returns the value of envVar, which names an object that
+// cannot be created through the API. Against the fake API a fresh object is
+// seeded instead; otherwise the test is skipped when envVar is not set.
+func testAccFixture(t *testing.T, envVar string, seed func(*fakeAPIServer) string) string {
+	if testFakeAPI != nil {
+		return seed(testFakeAPI)
+	}
+
+	value := os.Getenv(envVar)
+	if value == "" {
+		t.Skipf("%s not set, skipping acceptance test", envVar)
+	}
+	return value
+}
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+// testNullConfig returns a configuration of schema with every attribute null,
+// so the provider resolves all its settings from the environment
+func testNullConfig(t *testing.T, schema *tfprotov6.Schema) *tfprotov6.DynamicValue {
+	t.Helper()
+	objectType := schema.ValueType().(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	config, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, values))
+	require.NoError(t, err)
+	return &config
+}
+
+// TestProviderConfigure_fakeAPI configures the provider from the environment
+// TestMain prepares and reads a data source, so OAuth, the CA bundle, the
+// child organization header and the token cache are exercised end to end.
+func TestProviderConfigure_fakeAPI(t *testing.T) {
+	if testFakeAPI == nil {
+		t.Skip("runs against the fake API only")
+	}
+	ctx := context.Background()
+	tokenCacheDir := t.TempDir()
+	t.Setenv(envTokenCachePath, tokenCacheDir)
+
+	readRegions := func(t *testing.T, childOrgID string) []*tfprotov6.Diagnostic {
+		t.Setenv(envChildOrgID, childOrgID)
+		server, err := testAccCiscoSecureAccessProviderFactories["ciscosecureaccess"]()
+		require.NoError(t, err)
+		schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+		require.NoError(t, err)
+
+		configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: testNullConfig(t, schemas.Provider)})
+		require.NoError(t, err)
+		require.Empty(t, configureResp.Diagnostics)
+
+		readResp, err := server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{
+			TypeName: "ciscosecureaccess_regions",
+			Config:   testNullConfig(t, schemas.DataSourceSchemas["ciscosecureaccess_regions"]),
+		})
+		require.NoError(t, err)
+		return readResp.Diagnostics
+	}
+
+	t.Run("child org", func(t *testing.T) {
+		require.Empty(t, readRegions(t, strconv.Itoa(fakeAPIOrgID+3)))
+		cached, err := os.ReadDir(tokenCacheDir)
+		require.NoError(t, err)
+		require.Len(t, cached, 1, "token not written to the token cache")
+	})
+
+	t.Run("unmanaged org", func(t *testing.T) {
+		diags := readRegions(t, strconv.Itoa(fakeAPIOrgID+99))
+		require.NotEmpty(t, diags, "token issued for an organization the parent does not manage")
+		require.Contains(t, diags[0].Detail, "Forbidden")
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"golang.org/x/oauth2"
+)
+
+// defaultRegion is the region whose endpoint is used when neither region nor
+// api_endpoint is configured
+const defaultRegion = "us"
+
+// regionEndpoints maps each Secure Access region to the API host serving it.
+// The token endpoint and every API (policies, private apps, destination
+// lists, deployments and reports) are served from the same host. Only hosts
+// documented by Cisco are listed; others are reached through api_endpoint.
+var regionEndpoints = map[string]string{
+	"us": defaultAPIEndpoint,
+}
+
+// regionNames returns the supported regions in sorted order
+func regionNames() []string {
+	names := make([]string, 0, len(regionEndpoints))
+	for name := range regionEndpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resolveAPIEndpoint resolves the API host from region and api_endpoint. The
+// provider configuration takes precedence over the environment, and the two
+// attributes may not both be set at the same level. The returned region is
+// empty for a custom endpoint.
+func resolveAPIEndpoint(config ciscosecureaccessProviderModel) (endpoint, region string, diags diag.Diagnostics) {
+	endpoint, region = config.APIEndpoint.ValueString(), config.Region.ValueString()
+	source := "provider configuration"
+	if endpoint == "" && region == "" {
+		endpoint, region = os.Getenv(envAPIEndpoint), os.Getenv(envRegion)
+		source = fmt.Sprintf("%s and %s environment variables", envAPIEndpoint, envRegion)
+	}
+
+	switch {
+	case endpoint != "" && region != "":
+		diags.AddAttributeError(
+			path.Root("region"),
+			"Conflicting API Endpoint Configuration",
+			fmt.Sprintf("Only one of region and api_endpoint may be set, both are set in the %s.", source),
+		)
+	case region != "":
+		var ok bool
+		if endpoint, ok = regionEndpoints[region]; !ok {
+			diags.AddAttributeError(
+				path.Root("region"),
+				"Invalid Region",
+				fmt.Sprintf("Region %q is not supported, it must be one of: %s. Check the %s.", region, strings.Join(regionNames(), ", "), source),
+			)
+		}
+	case endpoint != "":
+		if err := validateAPIEndpoint(endpoint); err != nil {
+			diags.AddAttributeError(
+				path.Root("api_endpoint"),
+				"Invalid API Endpoint",
+				fmt.Sprintf("api_endpoint %q is not valid: %s. Check the %s.", endpoint, err.Error(), source),
+			)
+		}
+	default:
+		endpoint, region = defaultAPIEndpoint, defaultRegion
+	}
+
+	return endpoint, region, diags
+}
+
+// validateAPIEndpoint checks that endpoint is a host name or IP address with
+// an optional port. The provider adds the https scheme and the API paths.
+func validateAPIEndpoint(endpoint string) error {
+	if strings.Contains(endpoint, "://") {
+		return errors.New("it must be a host name such as " + defaultAPIEndpoint + ", without a scheme")
+	}
+	u, err := url.Parse("https://" + endpoint)
+	if err != nil || u.Host != endpoint || u.Hostname() == "" || strings.ContainsAny(endpoint, " \t") {
+		return errors.New("it must be a host name with an optional port, such as " + defaultAPIEndpoint + ", without a path")
+	}
+	if port := u.Port(); port != "" {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return fmt.Errorf("port %q is out of range", port)
+		}
+	}
+	return nil
+}
+
+// regionHintTransport explains token request failures caused by credentials
+// that belong to an organization in another region. The token endpoint of one
+// region rejects API keys created in another with the same error as an
+// unknown key.
+type regionHintTransport struct {
+	base     http.RoundTripper
+	endpoint string
+	region   string
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *regionHintTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	var tokenErr *oauth2.RetrieveError
+	if err == nil || !errors.As(err, &tokenErr) || tokenErr.Response == nil {
+		return resp, err
+	}
+	if status := tokenErr.Response.StatusCode; status != http.StatusBadRequest && status != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	where := t.endpoint
+	if t.region != "" {
+		where = fmt.Sprintf("%s (region %q)", t.endpoint, t.region)
+	}
+	return resp, fmt.Errorf("%w\n\nThe API key was rejected by %s. Check that key_id and key_secret are correct and that "+
+		"they belong to an organization served by this endpoint; if the organization is served by another endpoint, set the "+
+		"provider region attribute to one of: %s, or api_endpoint to its host", err, where, strings.Join(regionNames(), ", "))
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/oauth2"
+)
+
+// roundTripFunc adapts a function to http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestResolveAPIEndpoint(t *testing.T) {
+	model := func(endpoint, region types.String) ciscosecureaccessProviderModel {
+		return ciscosecureaccessProviderModel{APIEndpoint: endpoint, Region: region}
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv(envAPIEndpoint, "")
+		t.Setenv(envRegion, "")
+		endpoint, region, diags := resolveAPIEndpoint(model(types.StringNull(), types.StringNull()))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if endpoint != defaultAPIEndpoint || region != defaultRegion {
+			t.Errorf("got %s in region %q, want %s in region %q", endpoint, region, defaultAPIEndpoint, defaultRegion)
+		}
+	})
+
+	t.Run("region", func(t *testing.T) {
+		t.Setenv(envAPIEndpoint, "")
+		t.Setenv(envRegion, "")
+		endpoint, region, diags := resolveAPIEndpoint(model(types.StringNull(), types.StringValue("us")))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if endpoint != regionEndpoints["us"] || region != "us" {
+			t.Errorf("got %s in region %q, want %s in region us", endpoint, region, regionEndpoints["us"])
+		}
+	})
+
+	t.Run("configuration overrides environment", func(t *testing.T) {
+		t.Setenv(envAPIEndpoint, "")
+		t.Setenv(envRegion, "us")
+		endpoint, region, diags := resolveAPIEndpoint(model(types.StringValue("127.0.0.1:8443"), types.StringNull()))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if endpoint != "127.0.0.1:8443" || region != "" {
+			t.Errorf("got %s in region %q, want the custom endpoint without a region", endpoint, region)
+		}
+	})
+
+	t.Run("conflicting environment", func(t *testing.T) {
+		t.Setenv(envAPIEndpoint, defaultAPIEndpoint)
+		t.Setenv(envRegion, "us")
+		_, _, diags := resolveAPIEndpoint(model(types.StringNull(), types.StringNull()))
+		if diags.ErrorsCount() != 1 {
+			t.Errorf("got %d errors, want 1: %v", diags.ErrorsCount(), diags)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv(envAPIEndpoint, "")
+		t.Setenv(envRegion, "eu")
+		if _, _, diags := resolveAPIEndpoint(model(types.StringNull(), types.StringNull())); diags.ErrorsCount() != 1 {
+			t.Errorf("invalid region: got %d errors, want 1: %v", diags.ErrorsCount(), diags)
+		}
+		if _, _, diags := resolveAPIEndpoint(model(types.StringValue("https://api.sse.cisco.com"), types.StringNull())); diags.ErrorsCount() != 1 {
+			t.Errorf("invalid endpoint: got %d errors, want 1: %v", diags.ErrorsCount(), diags)
+		}
+	})
+}
+
+func TestValidateAPIEndpoint(t *testing.T) {
+	for endpoint, valid := range map[string]bool{
+		"api.sse.cisco.com":         true,
+		"127.0.0.1:8443":            true,
+		"[::1]:8443":                true,
+		"https://api.sse.cisco.com": false,
+		"api.sse.cisco.com/":        false,
+		"api.sse.cisco.com/auth/v2": false,
+		"api.sse.cisco.com?x=1":     false,
+		"user@api.sse.cisco.com":    false,
+		"api.sse.cisco.com:99999":   false,
+		"api .sse.cisco.com":        false,
+		":8443":                     false,
+	} {
+		if err := validateAPIEndpoint(endpoint); (err == nil) != valid {
+			t.Errorf("validateAPIEndpoint(%q) = %v, want valid %t", endpoint, err, valid)
+		}
+	}
+}
+
+func TestRegionHintTransport(t *testing.T) {
+	rejected := &oauth2.RetrieveError{Response: &http.Response{StatusCode: http.StatusUnauthorized}, ErrorCode: "invalid_client"}
+	transport := &regionHintTransport{
+		base: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return nil, rejected
+		}),
+		endpoint: regionEndpoints["us"],
+		region:   "us",
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://"+regionEndpoints["us"]+"/policies/v2/rules", nil)
+
+	_, err := transport.RoundTrip(req)
+	var tokenErr *oauth2.RetrieveError
+	if !errors.As(err, &tokenErr) {
+		t.Fatalf("error %v no longer wraps the token error", err)
+	}
+	if !strings.Contains(err.Error(), `region "us"`) || !strings.Contains(err.Error(), "set the provider region") || !strings.Contains(err.Error(), "api_endpoint") {
+		t.Errorf("error %q does not explain the region mismatch", err)
+	}
+
+	// Other errors pass through unchanged
+	unavailable := &oauth2.RetrieveError{Response: &http.Response{StatusCode: http.StatusServiceUnavailable}}
+	transport.base = roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return nil, unavailable
+	})
+	if _, err := transport.RoundTrip(req); err != unavailable {
+		t.Errorf("error = %v, want the original error", err)
+	}
+}
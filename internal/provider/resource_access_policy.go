@@ -14,8 +14,12 @@ import (
 	"time"
 
 	"github.com/avast/retry-go/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -46,8 +50,10 @@ const (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &accessPolicyResource{}
-	_ resource.ResourceWithConfigure = &accessPolicyResource{}
+	_ resource.Resource                   = &accessPolicyResource{}
+	_ resource.ResourceWithConfigure      = &accessPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &accessPolicyResource{}
+	_ resource.ResourceWithImportState    = &accessPolicyResource{}
 )
 
 // NewAccessPolicyResource is a helper function to simplify the provider implementation.
@@ -66,13 +72,21 @@ type accessPolicyResourceModel struct {
 	Name                    types.String `tfsdk:"name"`
 	Action                  types.String `tfsdk:"action"`
 	PrivateResourceIds      types.Set    `tfsdk:"private_resource_ids"`
+	PrivateResourceGroupIds types.Set    `tfsdk:"private_resource_group_ids"`
 	DestinationListIds      types.Set    `tfsdk:"destination_list_ids"`
 	ContentCategoryListIds  types.Set    `tfsdk:"content_category_list_ids"`
+	ApplicationIds          types.Set    `tfsdk:"application_ids"`
+	ApplicationListIds      types.Set    `tfsdk:"application_list_ids"`
+	ApplicationCategoryIds  types.Set    `tfsdk:"application_category_ids"`
 	Description             types.String `tfsdk:"description"`
 	Enabled                 types.Bool   `tfsdk:"enabled"`
 	LogLevel                types.String `tfsdk:"log_level"`
 	Priority                types.Int64  `tfsdk:"priority"`
 	ClientPostureProfileId  types.Int64  `tfsdk:"client_posture_profile_id"`
+	SecurityProfileId       types.Int64  `tfsdk:"security_profile_id"`
+	IpsProfileId            types.Int64  `tfsdk:"ips_profile_id"`
+	TenantControlProfileId  types.Int64  `tfsdk:"tenant_control_profile_id"`
+	ReauthenticationMinutes types.Int64  `tfsdk:"reauthentication_minutes"`
 	SourceIds               types.Set    `tfsdk:"source_ids"`
 	SourceTypes             types.Set    `tfsdk:"source_types"`
 	PrivateDestinationTypes types.Set    `tfsdk:"private_destination_types"`
@@ -80,6 +94,14 @@ type accessPolicyResourceModel struct {
 	TrafficType             types.String `tfsdk:"traffic_type"`
 }
 
+// accessPolicyResourceSchemaModel maps the whole resource schema: the access
+// policy attributes shared with the access_policies data source plus the
+// operation timeouts, which only the resource has.
+type accessPolicyResourceSchemaModel struct {
+	accessPolicyResourceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (m accessPolicyResourceModel) TrafficTypes() []string {
 	return []string{"PUBLIC_INTERNET", "PRIVATE_NETWORK"}
 }
@@ -125,10 +147,12 @@ func (r *accessPolicyResource) Configure(ctx context.Context, req resource.Confi
 }
 
 // Schema defines the schema for the resource.
-func (r *accessPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *accessPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		// TODO: Implement Internet rules
-		Description: "Access Policy rule, currently support private access rules only",
+		Description: "Access Policy rule for private access ('PRIVATE_NETWORK') or internet access ('PUBLIC_INTERNET'). " +
+			"The Access Rules API has no per-rule file inspection, SAML authentication or time-of-day schedule settings: " +
+			"enable file inspection and SAML authentication on the security profile referenced by security_profile_id, and " +
+			"enable or disable the rule with enabled instead of a schedule.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "Unique ID of access policy",
@@ -158,8 +182,17 @@ func (r *accessPolicyResource) Schema(_ context.Context, _ resource.SchemaReques
 				ElementType: types.Int64Type,
 				Optional:    true,
 				Validators: []validator.Set{
-					setvalidator.AtLeastOneOf(path.MatchRoot("private_resource_ids"), path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("private_destination_types"), path.MatchRoot("public_destination_types")),
-					setvalidator.ConflictsWith(path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids")),
+					setvalidator.AtLeastOneOf(path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids"), path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("application_ids"), path.MatchRoot("application_list_ids"), path.MatchRoot("application_category_ids"), path.MatchRoot("private_destination_types"), path.MatchRoot("public_destination_types")),
+					setvalidator.ConflictsWith(path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("application_ids"), path.MatchRoot("application_list_ids"), path.MatchRoot("application_category_ids")),
+				},
+			},
+			"private_resource_group_ids": schema.SetAttribute{
+				Description: "Secure Access IDs of matching private resource groups. Use the ciscosecureaccess_private_resource_group resource to manage groups.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.AtLeastOneOf(path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids"), path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("application_ids"), path.MatchRoot("application_list_ids"), path.MatchRoot("application_category_ids"), path.MatchRoot("private_destination_types"), path.MatchRoot("public_destination_types")),
+					setvalidator.ConflictsWith(path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("application_ids"), path.MatchRoot("application_list_ids"), path.MatchRoot("application_category_ids")),
 				},
 			},
 			"destination_list_ids": schema.SetAttribute{
@@ -167,8 +200,8 @@ func (r *accessPolicyResource) Schema(_ context.Context, _ resource.SchemaReques
 				ElementType: types.Int64Type,
 				Optional:    true,
 				Validators: []validator.Set{
-					setvalidator.AtLeastOneOf(path.MatchRoot("private_resource_ids"), path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("private_destination_types"), path.MatchRoot("public_destination_types")),
-					setvalidator.ConflictsWith(path.MatchRoot("private_resource_ids")),
+					setvalidator.AtLeastOneOf(path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids"), path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("application_ids"), path.MatchRoot("application_list_ids"), path.MatchRoot("application_category_ids"), path.MatchRoot("private_destination_types"), path.MatchRoot("public_destination_types")),
+					setvalidator.ConflictsWith(path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids")),
 				},
 			},
 			"content_category_list_ids": schema.SetAttribute{
@@ -176,8 +209,35 @@ func (r *accessPolicyResource) Schema(_ context.Context, _ resource.SchemaReques
 				ElementType: types.Int64Type,
 				Optional:    true,
 				Validators: []validator.Set{
-					setvalidator.AtLeastOneOf(path.MatchRoot("private_resource_ids"), path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("private_destination_types"), path.MatchRoot("public_destination_types")),
-					setvalidator.ConflictsWith(path.MatchRoot("private_resource_ids")),
+					setvalidator.AtLeastOneOf(path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids"), path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("application_ids"), path.MatchRoot("application_list_ids"), path.MatchRoot("application_category_ids"), path.MatchRoot("private_destination_types"), path.MatchRoot("public_destination_types")),
+					setvalidator.ConflictsWith(path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids")),
+				},
+			},
+			"application_ids": schema.SetAttribute{
+				Description: "Secure Access IDs of matching applications. Only valid for 'PUBLIC_INTERNET' rules",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.AtLeastOneOf(path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids"), path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("application_ids"), path.MatchRoot("application_list_ids"), path.MatchRoot("application_category_ids"), path.MatchRoot("private_destination_types"), path.MatchRoot("public_destination_types")),
+					setvalidator.ConflictsWith(path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids"), path.MatchRoot("private_destination_types")),
+				},
+			},
+			"application_list_ids": schema.SetAttribute{
+				Description: "Secure Access IDs of matching application lists. Application lists group individual applications and application categories. Only valid for 'PUBLIC_INTERNET' rules",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.AtLeastOneOf(path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids"), path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("application_ids"), path.MatchRoot("application_list_ids"), path.MatchRoot("application_category_ids"), path.MatchRoot("private_destination_types"), path.MatchRoot("public_destination_types")),
+					setvalidator.ConflictsWith(path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids"), path.MatchRoot("private_destination_types")),
+				},
+			},
+			"application_category_ids": schema.SetAttribute{
+				Description: "Secure Access IDs of matching application categories. Only valid for 'PUBLIC_INTERNET' rules",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.AtLeastOneOf(path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids"), path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("application_ids"), path.MatchRoot("application_list_ids"), path.MatchRoot("application_category_ids"), path.MatchRoot("private_destination_types"), path.MatchRoot("public_destination_types")),
+					setvalidator.ConflictsWith(path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids"), path.MatchRoot("private_destination_types")),
 				},
 			},
 			"description": schema.StringAttribute{
@@ -218,7 +278,7 @@ func (r *accessPolicyResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"priority": schema.Int64Attribute{
-				Description: "Priority at which to create rule (ascending)",
+				Description: "Priority at which to create rule (ascending). Leave unset when the rule is ordered by a ciscosecureaccess_access_policy_order resource, otherwise this resource and the order resource move the rule back and forth on every apply",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Int64{
@@ -232,6 +292,25 @@ func (r *accessPolicyResource) Schema(_ context.Context, _ resource.SchemaReques
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"security_profile_id": schema.Int64Attribute{
+				Description: "ID of the security profile applied to matching internet traffic. Only valid for 'PUBLIC_INTERNET' rules",
+				Optional:    true,
+			},
+			"ips_profile_id": schema.Int64Attribute{
+				Description: "ID of the intrusion prevention (IPS) profile applied to matching traffic",
+				Optional:    true,
+			},
+			"tenant_control_profile_id": schema.Int64Attribute{
+				Description: "ID of the tenant control profile applied to matching internet traffic. Only valid for 'PUBLIC_INTERNET' rules",
+				Optional:    true,
+			},
+			"reauthentication_minutes": schema.Int64Attribute{
+				Description: "Minutes after which users matching the rule must authenticate again. Unset keeps the sessions of authenticated users open. Only valid for 'PRIVATE_NETWORK' rules",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"source_ids": schema.SetAttribute{
 				Description: "Source Secure Access IDs of matching resource",
 				ElementType: types.Int64Type,
@@ -252,8 +331,8 @@ func (r *accessPolicyResource) Schema(_ context.Context, _ resource.SchemaReques
 				Optional:    true,
 				Validators: []validator.Set{
 					setvalidator.ValueStringsAre(stringvalidator.OneOf(accessPolicyResourceModel{}.ValidPrivateDestinationTypes()...)),
-					setvalidator.AtLeastOneOf(path.MatchRoot("private_destination_types"), path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("private_resource_ids"), path.MatchRoot("public_destination_types")),
-					setvalidator.ConflictsWith(path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("public_destination_types")),
+					setvalidator.AtLeastOneOf(path.MatchRoot("private_destination_types"), path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("application_ids"), path.MatchRoot("application_list_ids"), path.MatchRoot("application_category_ids"), path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids"), path.MatchRoot("public_destination_types")),
+					setvalidator.ConflictsWith(path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("application_ids"), path.MatchRoot("application_list_ids"), path.MatchRoot("application_category_ids"), path.MatchRoot("public_destination_types")),
 				},
 			},
 			"public_destination_types": schema.SetAttribute{
@@ -262,26 +341,92 @@ func (r *accessPolicyResource) Schema(_ context.Context, _ resource.SchemaReques
 				Optional:    true,
 				Validators: []validator.Set{
 					setvalidator.ValueStringsAre(stringvalidator.OneOf(accessPolicyResourceModel{}.ValidPublicDestinationTypes()...)),
-					setvalidator.AtLeastOneOf(path.MatchRoot("private_destination_types"), path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("private_resource_ids"), path.MatchRoot("public_destination_types")),
-					setvalidator.ConflictsWith(path.MatchRoot("private_resource_ids"), path.MatchRoot("private_destination_types")),
+					setvalidator.AtLeastOneOf(path.MatchRoot("private_destination_types"), path.MatchRoot("destination_list_ids"), path.MatchRoot("content_category_list_ids"), path.MatchRoot("application_ids"), path.MatchRoot("application_list_ids"), path.MatchRoot("application_category_ids"), path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids"), path.MatchRoot("public_destination_types")),
+					setvalidator.ConflictsWith(path.MatchRoot("private_resource_ids"), path.MatchRoot("private_resource_group_ids"), path.MatchRoot("private_destination_types")),
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+// ValidateConfig rejects attributes that do not apply to the traffic type of
+// the rule.
+func (r *accessPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config accessPolicyResourceSchemaModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateAccessPolicyTrafficType(&config.accessPolicyResourceModel)...)
+}
+
+// validateAccessPolicyTrafficType checks that attributes which only apply to
+// internet traffic are not set on a rule scoped to private traffic, and the
+// other way around
+func validateAccessPolicyTrafficType(config *accessPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// traffic_type is unknown until apply, nothing to check yet
+	if config.TrafficType.IsUnknown() {
+		return diags
+	}
+
+	type scopedAttribute struct {
+		name  string
+		value attr.Value
+	}
+	scoped := []scopedAttribute{
+		{"reauthentication_minutes", config.ReauthenticationMinutes},
+	}
+	trafficType := "PRIVATE_NETWORK"
+	if config.TrafficType.ValueString() != "PUBLIC_INTERNET" {
+		scoped = []scopedAttribute{
+			{"application_ids", config.ApplicationIds},
+			{"application_list_ids", config.ApplicationListIds},
+			{"application_category_ids", config.ApplicationCategoryIds},
+			{"security_profile_id", config.SecurityProfileId},
+			{"tenant_control_profile_id", config.TenantControlProfileId},
+		}
+		trafficType = "PUBLIC_INTERNET"
+	}
+	for _, a := range scoped {
+		if a.value.IsNull() {
+			continue
+		}
+		diags.AddAttributeError(
+			path.Root(a.name),
+			"Invalid Attribute For Traffic Type",
+			fmt.Sprintf("%s can only be set when traffic_type is \"%s\".", a.name, trafficType),
+		)
+	}
+
+	return diags
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *accessPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Info(ctx, "Creating Access Policy")
 	// Retrieve values from plan
-	var plan accessPolicyResourceModel
+	var plan accessPolicyResourceSchemaModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ruleDefinition := formatCreateAccessPolicyRequest(ctx, &plan)
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	ruleDefinition := formatCreateAccessPolicyRequest(ctx, &plan.accessPolicyResourceModel)
 
 	err := retry.Do(
 		func() error {
@@ -292,8 +437,8 @@ func (r *accessPolicyResource) Create(ctx context.Context, req resource.CreateRe
 					httpRes.Body.Close()
 					bodyStr := string(bodyBytes)
 
-					// Retryable errors
-					if httpRes.StatusCode == 400 && strings.Contains(bodyStr, "invalid data passed. the ID's provided for") || httpRes.StatusCode == 409 {
+					// Objects referenced by a new rule may not be visible to the rules API yet
+					if httpRes.StatusCode == 400 && strings.Contains(bodyStr, "invalid data passed. the ID's provided for") {
 						return fmt.Errorf("retryable error: %v - %s", err, bodyStr)
 					}
 
@@ -325,9 +470,7 @@ func (r *accessPolicyResource) Create(ctx context.Context, req resource.CreateRe
 			}
 			return nil
 		},
-		retry.Delay(time.Second*10), // More reasonable delay
-		retry.Attempts(6),
-		retry.Context(ctx),
+		retryUntilDeadline(ctx, time.Second*10)...,
 	)
 
 	if err != nil {
@@ -384,12 +527,20 @@ func formatCreateAccessPolicyRequest(ctx context.Context, plan *accessPolicyReso
 
 func (r *accessPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state accessPolicyResourceModel
+	var state accessPolicyResourceSchemaModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	resourceId := state.ID.ValueInt64()
 	tflog.Debug(ctx, "Retrieving access policy", map[string]interface{}{"id": resourceId})
 
@@ -417,86 +568,10 @@ func (r *accessPolicyResource) Read(ctx context.Context, req resource.ReadReques
 	}
 	defer httpRes.Body.Close()
 
-	// Parse rule conditions from API response
-	for _, condition := range readResp.RuleConditions {
-		switch {
-		case condition.AttributeName.AttributeNameDestination != nil:
-			switch string(*condition.AttributeName.AttributeNameDestination) {
-			case "umbrella.destination.private_resource_ids":
-				v, d := types.SetValueFrom(ctx, types.Int64Type, condition.AttributeValue.ArrayOfInt64)
-				resp.Diagnostics.Append(d...)
-				state.PrivateResourceIds = v
-			case "umbrella.destination.destination_list_ids":
-				v, d := types.SetValueFrom(ctx, types.Int64Type, condition.AttributeValue.ArrayOfInt64)
-				resp.Diagnostics.Append(d...)
-				state.DestinationListIds = v
-			case "umbrella.destination.category_list_ids":
-				v, d := types.SetValueFrom(ctx, types.Int64Type, condition.AttributeValue.ArrayOfInt64)
-				resp.Diagnostics.Append(d...)
-				state.ContentCategoryListIds = v
-			case "umbrella.destination.private_resource_types":
-				var typeNames []string
-				for _, typeId := range *condition.AttributeValue.ArrayOfString {
-					if typeId == PRIVATE_APPS_TYPE {
-						typeNames = append(typeNames, PRIVATE_APPS_SCHEMA)
-					}
-				}
-				v, d := types.SetValueFrom(ctx, types.StringType, typeNames)
-				resp.Diagnostics.Append(d...)
-				state.PrivateDestinationTypes = v
-			case "umbrella.destination.all":
-				if condition.AttributeValue.Bool != nil && *condition.AttributeValue.Bool {
-					publicTypes := []string{PUBLIC_INTERNET_SCHEMA}
-					v, d := types.SetValueFrom(ctx, types.StringType, publicTypes)
-					resp.Diagnostics.Append(d...)
-					state.PublicDestinationTypes = v
-				}
-			}
-		case condition.AttributeName.AttributeNameSource != nil:
-			switch string(*condition.AttributeName.AttributeNameSource) {
-			case "umbrella.source.identity_type_ids":
-				var typeNames []string
-				for _, typeId := range *condition.AttributeValue.ArrayOfInt64 {
-					if typeId == DIRECTORY_USERS_TYPE_ID {
-						typeNames = append(typeNames, DIRECTORY_USERS)
-					} else if typeId == NETWORKS_TYPE_ID {
-						typeNames = append(typeNames, NETWORKS)
-					}
-				}
-				v, d := types.SetValueFrom(ctx, types.StringType, typeNames)
-				resp.Diagnostics.Append(d...)
-				state.SourceTypes = v
-			case "umbrella.source.identity_ids":
-				v, d := types.SetValueFrom(ctx, types.Int64Type, condition.AttributeValue.ArrayOfInt64)
-				resp.Diagnostics.Append(d...)
-				state.SourceIds = v
-			}
-		}
-	}
-	// Parse rule settings from API response
-	for _, setting := range readResp.RuleSettings {
-		if setting.SettingName != nil {
-			switch string(*setting.SettingName) {
-			case string(rules.SETTINGNAME_UMBRELLA_LOG_LEVEL):
-				if setting.SettingValue.String != nil {
-					state.LogLevel = types.StringValue(*setting.SettingValue.String)
-				}
-			case string(rules.SETTINGNAME_UMBRELLA_POSTURE_PROFILE_ID_CLIENTBASED):
-				if setting.SettingValue.Int64 != nil {
-					state.ClientPostureProfileId = types.Int64Value(*setting.SettingValue.Int64)
-				}
-			case string(rules.SETTINGNAME_UMBRELLA_DEFAULT_TRAFFIC):
-				if setting.SettingValue.String != nil {
-					state.TrafficType = types.StringValue(*setting.SettingValue.String)
-				}
-			}
-		}
+	resp.Diagnostics.Append(parseAccessPolicyRule(ctx, readResp, &state.accessPolicyResourceModel)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	state.Name = types.StringValue(readResp.GetRuleName())
-	state.Action = types.StringValue(string(*readResp.RuleAction))
-	state.Description = types.StringValue(readResp.GetRuleDescription())
-	state.Enabled = types.BoolValue(readResp.GetRuleIsEnabled())
-	state.Priority = types.Int64Value(readResp.GetRulePriority())
 
 	tflog.Debug(ctx, "Successfully parsed access policy state", map[string]interface{}{
 		"id":   state.ID.ValueInt64(),
@@ -513,7 +588,7 @@ func (r *accessPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Info(ctx, "Updating access policy")
 
 	// Retrieve values from plan and state
-	var plan, state accessPolicyResourceModel
+	var plan, state accessPolicyResourceSchemaModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -526,9 +601,39 @@ func (r *accessPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Only update if there are actual changes
-	if hasChanges(&plan, &state) {
-		baseline := formatCreateAccessPolicyRequest(ctx, &plan)
+	if hasChanges(&plan.accessPolicyResourceModel, &state.accessPolicyResourceModel) {
+		// Without a configured priority the rule keeps its current position,
+		// which an access_policy_order resource may have changed since refresh
+		var configPriority types.Int64
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("priority"), &configPriority)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if configPriority.IsNull() {
+			current, httpRes, err := r.client.AccessRulesAPI.GetRule(ctx, plan.ID.ValueInt64()).Execute()
+			if httpRes != nil {
+				httpRes.Body.Close()
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error updating access policy",
+					fmt.Sprintf("Could not read current priority of access policy ID %d: %v", plan.ID.ValueInt64(), err),
+				)
+				return
+			}
+			plan.Priority = types.Int64Value(current.GetRulePriority())
+		}
+
+		baseline := formatCreateAccessPolicyRequest(ctx, &plan.accessPolicyResourceModel)
 		payload := rules.NewPutRuleRequest(
 			baseline.RuleName,
 			baseline.RuleAction,
@@ -561,38 +666,31 @@ func (r *accessPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *accessPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state accessPolicyResourceModel
+	var state accessPolicyResourceSchemaModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Delete existing access policy with retry logic
-	err := retry.Do(
-		func() error {
-			httpRes, err := r.client.AccessRulesAPI.DeleteRule(ctx, state.ID.ValueInt64()).Execute()
-			if httpRes != nil {
-				defer httpRes.Body.Close()
-			}
-			if err != nil {
-				if httpRes != nil && httpRes.StatusCode == 404 {
-					// Resource already deleted
-					return nil
-				}
-				if httpRes != nil && httpRes.StatusCode == 409 {
-					// Conflict - retry
-					return fmt.Errorf("conflict deleting access policy: %v", httpRes.StatusCode)
-				}
-				return retry.Unrecoverable(fmt.Errorf("failed to delete access policy: %w", err))
-			}
-			return nil
-		},
-		retry.Delay(time.Second*5),
-		retry.Attempts(3),
-	)
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
+	// Delete existing access policy; conflicts are retried by the HTTP client
+	httpRes, err := r.client.AccessRulesAPI.DeleteRule(ctx, state.ID.ValueInt64()).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
 	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == 404 {
+			// Resource already deleted
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error deleting access policy",
 			fmt.Sprintf("Could not delete access policy ID %s: %s", state.ID.String(), err.Error()),
@@ -600,6 +698,166 @@ func (r *accessPolicyResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 }
 
+// ImportState imports an existing access policy by its numeric rule ID.
+func (r *accessPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected numeric access policy rule ID, got: %s", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// parsePrivateResourceTypes maps the private resource types of a condition
+// onto their schema names
+func parsePrivateResourceTypes(ctx context.Context, value *rules.AttributeValue) (types.Set, diag.Diagnostics) {
+	var typeNames []string
+	if value != nil && value.ArrayOfString != nil {
+		for _, typeId := range *value.ArrayOfString {
+			if typeId == PRIVATE_APPS_TYPE {
+				typeNames = append(typeNames, PRIVATE_APPS_SCHEMA)
+			}
+		}
+	}
+	return types.SetValueFrom(ctx, types.StringType, typeNames)
+}
+
+// parseAccessPolicyRule maps the conditions, settings and properties of a rule
+// returned by the API onto the model. Attributes without a matching condition
+// or setting are null, so conditions and settings removed outside Terraform
+// show up as drift.
+func parseAccessPolicyRule(ctx context.Context, rule *rules.Rule, m *accessPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	*m = newAccessPolicyModel(m.ID.ValueInt64())
+	var reauthenticationEnabled bool
+
+	// Parse rule conditions from API response
+	for _, condition := range rule.RuleConditions {
+		switch {
+		case condition.AttributeName.AttributeNameDestination != nil:
+			switch string(*condition.AttributeName.AttributeNameDestination) {
+			case "umbrella.destination.private_resource_ids":
+				v, d := types.SetValueFrom(ctx, types.Int64Type, condition.AttributeValue.ArrayOfInt64)
+				diags.Append(d...)
+				m.PrivateResourceIds = v
+			case "umbrella.destination.private_application_group_ids":
+				v, d := types.SetValueFrom(ctx, types.Int64Type, condition.AttributeValue.ArrayOfInt64)
+				diags.Append(d...)
+				m.PrivateResourceGroupIds = v
+			case "umbrella.destination.destination_list_ids":
+				v, d := types.SetValueFrom(ctx, types.Int64Type, condition.AttributeValue.ArrayOfInt64)
+				diags.Append(d...)
+				m.DestinationListIds = v
+			case "umbrella.destination.category_list_ids":
+				v, d := types.SetValueFrom(ctx, types.Int64Type, condition.AttributeValue.ArrayOfInt64)
+				diags.Append(d...)
+				m.ContentCategoryListIds = v
+			case "umbrella.destination.application_ids":
+				v, d := types.SetValueFrom(ctx, types.Int64Type, condition.AttributeValue.ArrayOfInt64)
+				diags.Append(d...)
+				m.ApplicationIds = v
+			case "umbrella.destination.application_list_ids":
+				v, d := types.SetValueFrom(ctx, types.Int64Type, condition.AttributeValue.ArrayOfInt64)
+				diags.Append(d...)
+				m.ApplicationListIds = v
+			case "umbrella.destination.category_ids":
+				v, d := types.SetValueFrom(ctx, types.Int64Type, condition.AttributeValue.ArrayOfInt64)
+				diags.Append(d...)
+				m.ApplicationCategoryIds = v
+			case "umbrella.destination.private_resource_types":
+				v, d := parsePrivateResourceTypes(ctx, condition.AttributeValue)
+				diags.Append(d...)
+				m.PrivateDestinationTypes = v
+			case "umbrella.destination.all":
+				if condition.AttributeValue.Bool != nil && *condition.AttributeValue.Bool {
+					publicTypes := []string{PUBLIC_INTERNET_SCHEMA}
+					v, d := types.SetValueFrom(ctx, types.StringType, publicTypes)
+					diags.Append(d...)
+					m.PublicDestinationTypes = v
+				}
+			}
+		case condition.AttributeName.AttributeNameSource != nil:
+			switch string(*condition.AttributeName.AttributeNameSource) {
+			case "umbrella.source.identity_type_ids":
+				var typeNames []string
+				for _, typeId := range *condition.AttributeValue.ArrayOfInt64 {
+					if typeId == DIRECTORY_USERS_TYPE_ID {
+						typeNames = append(typeNames, DIRECTORY_USERS)
+					} else if typeId == NETWORKS_TYPE_ID {
+						typeNames = append(typeNames, NETWORKS)
+					}
+				}
+				v, d := types.SetValueFrom(ctx, types.StringType, typeNames)
+				diags.Append(d...)
+				m.SourceTypes = v
+			case "umbrella.source.identity_ids":
+				v, d := types.SetValueFrom(ctx, types.Int64Type, condition.AttributeValue.ArrayOfInt64)
+				diags.Append(d...)
+				m.SourceIds = v
+			// The SDK lists the private resource types among the source
+			// attribute names, so responses decode it here
+			case string(rules.ATTRIBUTENAMESOURCE_UMBRELLA_DESTINATION_PRIVATE_RESOURCE_TYPES):
+				v, d := parsePrivateResourceTypes(ctx, condition.AttributeValue)
+				diags.Append(d...)
+				m.PrivateDestinationTypes = v
+			}
+		}
+	}
+	// Parse rule settings from API response
+	for _, setting := range rule.RuleSettings {
+		if setting.SettingName != nil {
+			switch string(*setting.SettingName) {
+			case string(rules.SETTINGNAME_UMBRELLA_LOG_LEVEL):
+				if setting.SettingValue.String != nil {
+					m.LogLevel = types.StringValue(*setting.SettingValue.String)
+				}
+			case string(rules.SETTINGNAME_UMBRELLA_POSTURE_PROFILE_ID_CLIENTBASED):
+				if setting.SettingValue.Int64 != nil {
+					m.ClientPostureProfileId = types.Int64Value(*setting.SettingValue.Int64)
+				}
+			case string(rules.SETTINGNAME_UMBRELLA_POSTURE_WEB_PROFILE_ID):
+				if setting.SettingValue.Int64 != nil {
+					m.SecurityProfileId = types.Int64Value(*setting.SettingValue.Int64)
+				}
+			case string(rules.SETTINGNAME_UMBRELLA_POSTURE_IPS_PROFILE_ID):
+				if setting.SettingValue.Int64 != nil {
+					m.IpsProfileId = types.Int64Value(*setting.SettingValue.Int64)
+				}
+			case string(rules.SETTINGNAME_SSE_TENANT_CONTROL_PROFILE_ID):
+				if setting.SettingValue.Int64 != nil {
+					m.TenantControlProfileId = types.Int64Value(*setting.SettingValue.Int64)
+				}
+			case string(rules.SETTINGNAME_SSE_ZTA_AUTHN_TIMEOUT_ENABLED):
+				reauthenticationEnabled = setting.SettingValue.Bool != nil && *setting.SettingValue.Bool
+			case string(rules.SETTINGNAME_SSE_ZTA_AUTHN_TIMEOUT_MINUTES):
+				if setting.SettingValue.Int64 != nil {
+					m.ReauthenticationMinutes = types.Int64Value(*setting.SettingValue.Int64)
+				}
+			case string(rules.SETTINGNAME_UMBRELLA_DEFAULT_TRAFFIC):
+				if setting.SettingValue.String != nil {
+					m.TrafficType = types.StringValue(*setting.SettingValue.String)
+				}
+			}
+		}
+	}
+	// The timeout is only in effect while it is enabled
+	if !reauthenticationEnabled {
+		m.ReauthenticationMinutes = types.Int64Null()
+	}
+	m.Name = types.StringValue(rule.GetRuleName())
+	m.Action = types.StringValue(string(rule.GetRuleAction()))
+	// The API returns an empty description for rules created without one
+	if rule.GetRuleDescription() != "" {
+		m.Description = types.StringValue(rule.GetRuleDescription())
+	} else {
+		m.Description = types.StringNull()
+	}
+	m.Enabled = types.BoolValue(rule.GetRuleIsEnabled())
+	m.Priority = types.Int64Value(rule.GetRulePriority())
+
+	return diags
+}
+
 // Helper functions for building rule conditions
 
 func buildSourceConditions(ctx context.Context, plan *accessPolicyResourceModel) []rules.RuleConditionsInner {
@@ -656,6 +914,19 @@ func buildDestinationConditions(ctx context.Context, plan *accessPolicyResourceM
 		conditions = append(conditions, *condition)
 	}
 
+	// Private resource group IDs condition; the rules API still names
+	// private resource groups private application groups
+	var privateResourceGroupIds []int64
+	plan.PrivateResourceGroupIds.ElementsAs(ctx, &privateResourceGroupIds, true)
+	if len(privateResourceGroupIds) > 0 {
+		condition := rules.NewRuleConditionsInner()
+		destinationName := rules.AttributeNameDestination("umbrella.destination.private_application_group_ids")
+		condition.SetAttributeName(rules.AttributeName{AttributeNameDestination: &destinationName})
+		condition.SetAttributeValue(rules.ArrayOfInt64AsAttributeValue(&privateResourceGroupIds))
+		condition.SetAttributeOperator("IN")
+		conditions = append(conditions, *condition)
+	}
+
 	// Destination list IDs condition
 	var destinationListIds []int64
 	plan.DestinationListIds.ElementsAs(ctx, &destinationListIds, true)
@@ -680,6 +951,42 @@ func buildDestinationConditions(ctx context.Context, plan *accessPolicyResourceM
 		conditions = append(conditions, *condition)
 	}
 
+	// Application IDs condition
+	var applicationIds []int64
+	plan.ApplicationIds.ElementsAs(ctx, &applicationIds, true)
+	if len(applicationIds) > 0 {
+		condition := rules.NewRuleConditionsInner()
+		destinationName := rules.AttributeNameDestination("umbrella.destination.application_ids")
+		condition.SetAttributeName(rules.AttributeName{AttributeNameDestination: &destinationName})
+		condition.SetAttributeValue(rules.ArrayOfInt64AsAttributeValue(&applicationIds))
+		condition.SetAttributeOperator("INTERSECT")
+		conditions = append(conditions, *condition)
+	}
+
+	// Application list IDs condition
+	var applicationListIds []int64
+	plan.ApplicationListIds.ElementsAs(ctx, &applicationListIds, true)
+	if len(applicationListIds) > 0 {
+		condition := rules.NewRuleConditionsInner()
+		destinationName := rules.AttributeNameDestination("umbrella.destination.application_list_ids")
+		condition.SetAttributeName(rules.AttributeName{AttributeNameDestination: &destinationName})
+		condition.SetAttributeValue(rules.ArrayOfInt64AsAttributeValue(&applicationListIds))
+		condition.SetAttributeOperator("INTERSECT")
+		conditions = append(conditions, *condition)
+	}
+
+	// Application category IDs condition
+	var applicationCategoryIds []int64
+	plan.ApplicationCategoryIds.ElementsAs(ctx, &applicationCategoryIds, true)
+	if len(applicationCategoryIds) > 0 {
+		condition := rules.NewRuleConditionsInner()
+		destinationName := rules.AttributeNameDestination("umbrella.destination.category_ids")
+		condition.SetAttributeName(rules.AttributeName{AttributeNameDestination: &destinationName})
+		condition.SetAttributeValue(rules.ArrayOfInt64AsAttributeValue(&applicationCategoryIds))
+		condition.SetAttributeOperator("INTERSECT")
+		conditions = append(conditions, *condition)
+	}
+
 	// Private destination types condition
 	var privateTypeNames []string
 	var privateTypes []string
@@ -734,6 +1041,43 @@ func buildRuleSettings(plan *accessPolicyResourceModel) []rules.RuleSettingsInne
 		settings = append(settings, clientPostureSetting)
 	}
 
+	// Security (web) profile setting
+	if !plan.SecurityProfileId.IsNull() {
+		securityProfileId := plan.SecurityProfileId.ValueInt64()
+		securityProfileSetting := rules.RuleSettingsInner{SettingValue: &rules.SettingValue{Int64: &securityProfileId}}
+		securityProfileSetting.SetSettingName(rules.SETTINGNAME_UMBRELLA_POSTURE_WEB_PROFILE_ID)
+		settings = append(settings, securityProfileSetting)
+	}
+
+	// IPS profile setting
+	if !plan.IpsProfileId.IsNull() {
+		ipsProfileId := plan.IpsProfileId.ValueInt64()
+		ipsProfileSetting := rules.RuleSettingsInner{SettingValue: &rules.SettingValue{Int64: &ipsProfileId}}
+		ipsProfileSetting.SetSettingName(rules.SETTINGNAME_UMBRELLA_POSTURE_IPS_PROFILE_ID)
+		settings = append(settings, ipsProfileSetting)
+	}
+
+	// Tenant control profile setting
+	if !plan.TenantControlProfileId.IsNull() {
+		tenantControlProfileId := plan.TenantControlProfileId.ValueInt64()
+		tenantControlSetting := rules.RuleSettingsInner{SettingValue: &rules.SettingValue{Int64: &tenantControlProfileId}}
+		tenantControlSetting.SetSettingName(rules.SETTINGNAME_SSE_TENANT_CONTROL_PROFILE_ID)
+		settings = append(settings, tenantControlSetting)
+	}
+
+	// Reauthentication settings; the timeout only applies while enabled
+	if !plan.ReauthenticationMinutes.IsNull() {
+		reauthenticationEnabled := true
+		enabledSetting := rules.RuleSettingsInner{SettingValue: &rules.SettingValue{Bool: &reauthenticationEnabled}}
+		enabledSetting.SetSettingName(rules.SETTINGNAME_SSE_ZTA_AUTHN_TIMEOUT_ENABLED)
+		settings = append(settings, enabledSetting)
+
+		reauthenticationMinutes := plan.ReauthenticationMinutes.ValueInt64()
+		minutesSetting := rules.RuleSettingsInner{SettingValue: &rules.SettingValue{Int64: &reauthenticationMinutes}}
+		minutesSetting.SetSettingName(rules.SETTINGNAME_SSE_ZTA_AUTHN_TIMEOUT_MINUTES)
+		settings = append(settings, minutesSetting)
+	}
+
 	// Traffic type setting
 	trafficString := plan.TrafficType.ValueString()
 	trafficSetting := rules.NewRuleSettingsInner()
@@ -765,9 +1109,17 @@ func hasChanges(plan, state *accessPolicyResourceModel) bool {
 		!plan.PrivateDestinationTypes.Equal(state.PrivateDestinationTypes) ||
 		!plan.PublicDestinationTypes.Equal(state.PublicDestinationTypes) ||
 		!plan.PrivateResourceIds.Equal(state.PrivateResourceIds) ||
+		!plan.PrivateResourceGroupIds.Equal(state.PrivateResourceGroupIds) ||
 		!plan.DestinationListIds.Equal(state.DestinationListIds) ||
 		!plan.ContentCategoryListIds.Equal(state.ContentCategoryListIds) ||
+		!plan.ApplicationIds.Equal(state.ApplicationIds) ||
+		!plan.ApplicationListIds.Equal(state.ApplicationListIds) ||
+		!plan.ApplicationCategoryIds.Equal(state.ApplicationCategoryIds) ||
 		!plan.LogLevel.Equal(state.LogLevel) ||
 		!plan.ClientPostureProfileId.Equal(state.ClientPostureProfileId) ||
+		!plan.SecurityProfileId.Equal(state.SecurityProfileId) ||
+		!plan.IpsProfileId.Equal(state.IpsProfileId) ||
+		!plan.TenantControlProfileId.Equal(state.TenantControlProfileId) ||
+		!plan.ReauthenticationMinutes.Equal(state.ReauthenticationMinutes) ||
 		!plan.TrafficType.Equal(state.TrafficType)
 }
@@ -0,0 +1,341 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/CiscoDevNet/go-ciscosecureaccess/rules"
+)
+
+var (
+	_ resource.Resource                = &accessPolicyOrderResource{}
+	_ resource.ResourceWithConfigure   = &accessPolicyOrderResource{}
+	_ resource.ResourceWithImportState = &accessPolicyOrderResource{}
+)
+
+// Static ID for the access policy order singleton resource
+const accessPolicyOrderResourceID = "access-policy-order"
+
+// NewAccessPolicyOrderResource is a helper function to simplify the provider implementation.
+func NewAccessPolicyOrderResource() resource.Resource {
+	return &accessPolicyOrderResource{}
+}
+
+// accessPolicyOrderResource enforces the relative priority of access policy rules
+type accessPolicyOrderResource struct {
+	client rules.APIClient
+}
+
+// accessPolicyOrderResourceModel maps the resource schema data.
+type accessPolicyOrderResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	RuleIds       types.List     `tfsdk:"rule_ids"`
+	StartPriority types.Int64    `tfsdk:"start_priority"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *accessPolicyOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_policy_order"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *accessPolicyOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	factory, ok := req.ProviderData.(*client.SSEClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data Type",
+			fmt.Sprintf("expected *client.SSEClientFactory, got %T", req.ProviderData))
+		return
+	}
+	r.client = *factory.GetRulesClient(ctx)
+}
+
+// Schema defines the schema for the resource.
+func (r *accessPolicyOrderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Order of access policy rules. Rules are assigned consecutive priorities in list order, starting at start_priority. " +
+			"Leave priority unset on ciscosecureaccess_access_policy resources whose order is managed here, otherwise both resources move the rule on every apply. " +
+			"Rules of other policies placed between the ordered rules are detected as drift.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the access policy order resource",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rule_ids": schema.ListAttribute{
+				Description: "Access policy rule IDs in the order they should be evaluated",
+				ElementType: types.Int64Type,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"start_priority": schema.Int64Attribute{
+				Description: "Priority assigned to the first rule in rule_ids. Defaults to 1",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Create enforces the configured rule order.
+func (r *accessPolicyOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan accessPolicyOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyOrder(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(accessPolicyOrderResourceID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the rule order from the current rule priorities.
+func (r *accessPolicyOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state accessPolicyOrderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	var ruleIds []int64
+	resp.Diagnostics.Append(state.RuleIds.ElementsAs(ctx, &ruleIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorities := make(map[int64]int64, len(ruleIds))
+	var current []int64
+	for _, ruleId := range ruleIds {
+		rule, httpRes, err := r.client.AccessRulesAPI.GetRule(ctx, ruleId).Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == 404 {
+				// Drop deleted rules so the next plan puts them back
+				tflog.Info(ctx, "Ordered access policy not found", map[string]interface{}{"id": ruleId})
+				continue
+			}
+			resp.Diagnostics.AddError(
+				"Error reading access policy order",
+				fmt.Sprintf("Cannot read access policy ID %d: %s", ruleId, err.Error()),
+			)
+			return
+		}
+		priorities[ruleId] = rule.GetRulePriority()
+		current = append(current, ruleId)
+	}
+
+	current = consecutiveRules(current, priorities)
+
+	v, d := types.ListValueFrom(ctx, types.Int64Type, current)
+	resp.Diagnostics.Append(d...)
+	state.RuleIds = v
+	if len(current) > 0 {
+		state.StartPriority = types.Int64Value(priorities[current[0]])
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// consecutiveRules sorts ruleIds by priority and keeps the rules up to the
+// first gap. A gap means another rule sits between the ordered rules, or the
+// rules were moved apart, so dropping the rules after it makes the next plan
+// put them back in place.
+func consecutiveRules(ruleIds []int64, priorities map[int64]int64) []int64 {
+	sort.SliceStable(ruleIds, func(i, j int) bool {
+		return priorities[ruleIds[i]] < priorities[ruleIds[j]]
+	})
+	for i := 1; i < len(ruleIds); i++ {
+		if priorities[ruleIds[i]] != priorities[ruleIds[i-1]]+1 {
+			return ruleIds[:i]
+		}
+	}
+	return ruleIds
+}
+
+// Update enforces the new rule order.
+func (r *accessPolicyOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan accessPolicyOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyOrder(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from state. Rules keep their current priorities.
+func (r *accessPolicyOrderResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing access policy order from state, rule priorities are left unchanged")
+}
+
+// ImportState imports the order of a comma-separated list of access policy
+// rule IDs. Read sorts them by their current priority.
+func (r *accessPolicyOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var ruleIds []int64
+	for _, part := range strings.Split(req.ID, ",") {
+		ruleId, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected comma-separated access policy rule IDs, got: %s", req.ID))
+			return
+		}
+		ruleIds = append(ruleIds, ruleId)
+	}
+
+	v, diags := types.ListValueFrom(ctx, types.Int64Type, ruleIds)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), accessPolicyOrderResourceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule_ids"), v)...)
+}
+
+// applyOrder walks the rules top-down and moves every rule that is not yet at
+// its target priority. Each rule is read just before it is placed, since the
+// API shifts the priorities of other rules when one is moved.
+func (r *accessPolicyOrderResource) applyOrder(ctx context.Context, plan *accessPolicyOrderResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var ruleIds []int64
+	diags.Append(plan.RuleIds.ElementsAs(ctx, &ruleIds, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, ruleId := range ruleIds {
+		priority := plan.StartPriority.ValueInt64() + int64(i)
+
+		rule, httpRes, err := r.client.AccessRulesAPI.GetRule(ctx, ruleId).Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err != nil {
+			diags.AddError(
+				"Error ordering access policies",
+				fmt.Sprintf("Cannot read access policy ID %d: %s", ruleId, err.Error()),
+			)
+			return diags
+		}
+		if rule.GetRulePriority() == priority {
+			continue
+		}
+
+		tflog.Debug(ctx, "Moving access policy", map[string]interface{}{
+			"id":   ruleId,
+			"from": rule.GetRulePriority(),
+			"to":   priority,
+		})
+
+		_, httpRes, err = r.client.AccessRulesAPI.PutRule(ctx, ruleId).PutRuleRequest(*putRuleRequestWithPriority(rule, priority)).Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err != nil {
+			diags.AddError(
+				"Error ordering access policies",
+				fmt.Sprintf("Could not move access policy ID %d to priority %d: %s", ruleId, priority, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// putRuleRequestWithPriority builds a PutRule payload that keeps every field of
+// an existing rule except its priority
+func putRuleRequestWithPriority(rule *rules.Rule, priority int64) *rules.PutRuleRequest {
+	settings := make([]rules.RuleSettingsInner, 0, len(rule.RuleSettings))
+	for _, setting := range rule.RuleSettings {
+		settings = append(settings, rules.RuleSettingsInner{
+			SettingName:  setting.SettingName,
+			SettingValue: setting.SettingValue,
+		})
+	}
+
+	payload := rules.NewPutRuleRequest(
+		rule.GetRuleName(),
+		rule.GetRuleAction(),
+		priority,
+		rule.RuleConditions,
+		settings,
+	)
+	if rule.RuleDescription != nil {
+		payload.SetRuleDescription(rule.GetRuleDescription())
+	}
+	if rule.RuleIsEnabled != nil {
+		payload.SetRuleIsEnabled(rule.GetRuleIsEnabled())
+	}
+	return payload
+}
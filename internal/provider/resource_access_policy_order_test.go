@@ -0,0 +1,180 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/rules"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccessPolicyOrderResourceName = "ciscosecureaccess_access_policy_order.test_order"
+
+func TestAccessPolicyOrder_basic(t *testing.T) {
+	rateLimitedTest(t, func() {
+		testName := generateAccessPolicyTestName("order")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccAccessPolicyOrderConfig(testName, "first", "second", "third"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(testAccessPolicyOrderResourceName, "id", accessPolicyOrderResourceID),
+						resource.TestCheckResourceAttr(testAccessPolicyOrderResourceName, "start_priority", "1"),
+						resource.TestCheckResourceAttr(testAccessPolicyOrderResourceName, "rule_ids.#", "3"),
+						testAccCheckAccessPolicyOrder(1, "first", "second", "third"),
+					),
+				},
+				{
+					// Moving the last rule to the top reorders every rule in one apply
+					Config: testAccAccessPolicyOrderConfig(testName, "third", "first", "second"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(testAccessPolicyOrderResourceName, plancheck.ResourceActionUpdate),
+							plancheck.ExpectResourceAction("ciscosecureaccess_access_policy.first", plancheck.ResourceActionNoop),
+							plancheck.ExpectResourceAction("ciscosecureaccess_access_policy.second", plancheck.ResourceActionNoop),
+							plancheck.ExpectResourceAction("ciscosecureaccess_access_policy.third", plancheck.ResourceActionNoop),
+						},
+					},
+					Check: testAccCheckAccessPolicyOrder(1, "third", "first", "second"),
+				},
+				{
+					// Refreshed priorities on the rules must not produce a diff
+					Config: testAccAccessPolicyOrderConfig(testName, "third", "first", "second"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectEmptyPlan(),
+						},
+					},
+				},
+				{
+					ResourceName:      testAccessPolicyOrderResourceName,
+					ImportState:       true,
+					ImportStateIdFunc: testAccAccessPolicyOrderImportID("first", "second", "third"),
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+func TestConsecutiveRules(t *testing.T) {
+	priorities := map[int64]int64{10: 3, 20: 1, 30: 2, 40: 5}
+
+	if got := consecutiveRules([]int64{10, 20, 30}, priorities); fmt.Sprint(got) != "[20 30 10]" {
+		t.Errorf("consecutive rules = %v, want [20 30 10]", got)
+	}
+	// Another rule holds priority 4, between rule 10 and rule 40
+	if got := consecutiveRules([]int64{40, 10, 20, 30}, priorities); fmt.Sprint(got) != "[20 30 10]" {
+		t.Errorf("rules after a gap = %v, want [20 30 10]", got)
+	}
+}
+
+func TestPutRuleRequestWithPriority(t *testing.T) {
+	name := rules.AttributeNameDestination("umbrella.destination.all")
+	value := true
+	condition := rules.NewRuleConditionsInner()
+	condition.SetAttributeName(rules.AttributeName{AttributeNameDestination: &name})
+	condition.SetAttributeValue(rules.BoolAsAttributeValue(&value))
+	condition.SetAttributeOperator("=")
+
+	logLevel := "LOG_ALL"
+	settingName := rules.SETTINGNAME_UMBRELLA_LOG_LEVEL
+
+	rule := rules.NewRule()
+	rule.SetRuleName("rule")
+	rule.SetRuleDescription("description")
+	rule.SetRuleAction(rules.RuleAction("allow"))
+	rule.SetRuleIsEnabled(true)
+	rule.SetRulePriority(7)
+	rule.RuleConditions = []rules.RuleConditionsInner{*condition}
+	rule.RuleSettings = []rules.SettingResponseInner{{SettingName: &settingName, SettingValue: &rules.SettingValue{String: &logLevel}}}
+
+	payload := putRuleRequestWithPriority(rule, 2)
+
+	if payload.RulePriority != 2 {
+		t.Errorf("priority = %d, want 2", payload.RulePriority)
+	}
+	if payload.RuleName != "rule" || payload.GetRuleDescription() != "description" || string(payload.RuleAction) != "allow" || !payload.GetRuleIsEnabled() {
+		t.Errorf("rule fields not preserved: %+v", payload)
+	}
+	if len(payload.RuleConditions) != 1 || payload.RuleConditions[0].GetAttributeOperator() != "=" {
+		t.Errorf("conditions not preserved: %+v", payload.RuleConditions)
+	}
+	if len(payload.RuleSettings) != 1 || payload.RuleSettings[0].GetSettingName() != settingName || *payload.RuleSettings[0].SettingValue.String != logLevel {
+		t.Errorf("settings not preserved: %+v", payload.RuleSettings)
+	}
+}
+
+// testAccCheckAccessPolicyOrder verifies that the named access_policy
+// resources hold consecutive priorities from start in the API
+func testAccCheckAccessPolicyOrder(start int64, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+		c := testAccClientFactory().GetRulesClient(ctx)
+		for i, name := range names {
+			rs, ok := s.RootModule().Resources["ciscosecureaccess_access_policy."+name]
+			if !ok {
+				return fmt.Errorf("access policy %s not found in state", name)
+			}
+			rule, _, err := c.AccessRulesAPI.GetRule(ctx, atoi64(rs.Primary.ID)).Execute()
+			if err != nil {
+				return fmt.Errorf("reading access policy %s: %w", name, err)
+			}
+			if want := start + int64(i); rule.GetRulePriority() != want {
+				return fmt.Errorf("access policy %s has priority %d, want %d", name, rule.GetRulePriority(), want)
+			}
+		}
+		return nil
+	}
+}
+
+// testAccAccessPolicyOrderConfig returns three access policies without
+// priorities, ordered by an access_policy_order resource
+func testAccAccessPolicyOrderConfig(name string, order ...string) string {
+	config := ""
+	for _, policy := range []string{"first", "second", "third"} {
+		config += fmt.Sprintf(`
+resource "ciscosecureaccess_access_policy" "%[1]s" {
+    name = "%[2]s_%[1]s"
+    source_types = ["networks"]
+    private_destination_types = ["private_apps"]
+}
+`, policy, name)
+	}
+	return config + fmt.Sprintf(`
+resource "ciscosecureaccess_access_policy_order" "test_order" {
+    rule_ids = [
+        ciscosecureaccess_access_policy.%s.id,
+        ciscosecureaccess_access_policy.%s.id,
+        ciscosecureaccess_access_policy.%s.id,
+    ]
+}
+`, order[0], order[1], order[2])
+}
+
+// testAccAccessPolicyOrderImportID joins the IDs of the named access_policy
+// resources, in any order, into an import ID
+func testAccAccessPolicyOrderImportID(names ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		ids := make([]string, 0, len(names))
+		for _, name := range names {
+			rs, ok := s.RootModule().Resources["ciscosecureaccess_access_policy."+name]
+			if !ok {
+				return "", fmt.Errorf("access policy %s not found in state", name)
+			}
+			ids = append(ids, rs.Primary.ID)
+		}
+		return strings.Join(ids, ","), nil
+	}
+}
@@ -5,12 +5,19 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/CiscoDevNet/go-ciscosecureaccess/rules"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
@@ -149,6 +156,120 @@ func TestAccessPolicy_blockAction(t *testing.T) {
 	}, minWaitTime)
 }
 
+// TestAccessPolicy_import tests importing an access policy by rule ID
+func TestAccessPolicy_import(t *testing.T) {
+	rateLimitedTest(t, func() {
+		testName := generateAccessPolicyTestName("import")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccAccessPolicyImportConfig(testName),
+					Check:  commonAccessPolicyChecks(testAccessPolicyResourceName, testName),
+				},
+				{
+					ResourceName:      testAccessPolicyResourceName,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					// Replace state with the imported resource and make sure
+					// the configuration plans no changes against it
+					ResourceName:       testAccessPolicyResourceName,
+					ImportState:        true,
+					ImportStatePersist: true,
+				},
+				{
+					Config: testAccAccessPolicyImportConfig(testName),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectEmptyPlan(),
+						},
+					},
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+// TestAccessPolicy_privateResourceGroup tests an access policy targeting a
+// private resource group instead of individual private resources
+func TestAccessPolicy_privateResourceGroup(t *testing.T) {
+	rateLimitedTest(t, func() {
+		testName := generateAccessPolicyTestName("group")
+		groupName := generateConnectorGroupTestName("policy")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccAccessPolicyPrivateResourceGroupConfig(testName, groupName),
+					Check:  commonAccessPolicyChecks(testAccessPolicyResourceName, testName),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(testAccessPolicyResourceName, tfjsonpath.New("private_resource_group_ids"), knownvalue.SetSizeExact(1)),
+						statecheck.ExpectKnownValue(testAccessPolicyResourceName, tfjsonpath.New("private_resource_ids"), knownvalue.Null()),
+					},
+				},
+				{
+					ResourceName:      testAccessPolicyResourceName,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+// TestAccessPolicy_internetRule tests an internet access policy matching
+// applications with security and tenant control profiles
+func TestAccessPolicy_internetRule(t *testing.T) {
+	rateLimitedTest(t, func() {
+		testName := generateAccessPolicyTestName("internet")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					// Application conditions are rejected on private access rules
+					Config:      testAccAccessPolicyInternetRuleConfig(testName, "PRIVATE_NETWORK", 1008716),
+					ExpectError: regexp.MustCompile(`application_ids can only be set when traffic_type is "PUBLIC_INTERNET"`),
+				},
+				{
+					Config: testAccAccessPolicyInternetRuleConfig(testName, "PUBLIC_INTERNET", 1008716),
+					Check:  commonAccessPolicyChecks(testAccessPolicyResourceName, testName),
+					ConfigStateChecks: append(
+						commonAccessPolicyStateChecks(testAccessPolicyResourceName, testName),
+						statecheck.ExpectKnownValue(testAccessPolicyResourceName, tfjsonpath.New("traffic_type"), knownvalue.StringExact("PUBLIC_INTERNET")),
+						statecheck.ExpectKnownValue(testAccessPolicyResourceName, tfjsonpath.New("application_ids"), knownvalue.SetExact([]knownvalue.Check{knownvalue.Int64Exact(1008716)})),
+						statecheck.ExpectKnownValue(testAccessPolicyResourceName, tfjsonpath.New("security_profile_id"), knownvalue.Int64Exact(1234)),
+						statecheck.ExpectKnownValue(testAccessPolicyResourceName, tfjsonpath.New("tenant_control_profile_id"), knownvalue.Int64Exact(19383)),
+					),
+				},
+				{
+					Config: testAccAccessPolicyInternetRuleConfig(testName, "PUBLIC_INTERNET", 5000041),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(testAccessPolicyResourceName, plancheck.ResourceActionUpdate),
+						},
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(testAccessPolicyResourceName, tfjsonpath.New("application_ids"), knownvalue.SetExact([]knownvalue.Check{knownvalue.Int64Exact(5000041)})),
+					},
+				},
+				{
+					ResourceName:      testAccessPolicyResourceName,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
 // Configuration generators for different test scenarios
 
 // testAccAccessPolicyResource returns a configuration for a private network access policy
@@ -192,3 +313,198 @@ resource "ciscosecureaccess_access_policy" "test_resource" {
     description = "%s"
 }`, name, testAccessPolicyDescription)
 }
+
+// testAccAccessPolicyImportConfig returns a configuration that relies on
+// defaults and omits optional attributes, which import must leave unset
+func testAccAccessPolicyImportConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ciscosecureaccess_access_policy" "test_resource" {
+    name = "%s"
+    source_types = ["networks"]
+    private_destination_types = ["private_apps"]
+}`, name)
+}
+
+// testAccAccessPolicyInternetRuleConfig returns a configuration for an
+// internet access policy matching a single application
+func testAccAccessPolicyInternetRuleConfig(name, trafficType string, applicationID int64) string {
+	return fmt.Sprintf(`
+resource "ciscosecureaccess_access_policy" "test_resource" {
+    name = "%s"
+    action = "allow"
+    enabled = true
+    log_level = "LOG_ALL"
+    traffic_type = "%s"
+    source_types = ["directory_users"]
+    application_ids = [%d]
+    security_profile_id = 1234
+    tenant_control_profile_id = 19383
+    description = "%s"
+}`, name, trafficType, applicationID, testAccessPolicyDescription)
+}
+
+// testAccAccessPolicyPrivateResourceGroupConfig returns a configuration for an
+// access policy targeting a private resource group
+func testAccAccessPolicyPrivateResourceGroupConfig(name, groupName string) string {
+	return fmt.Sprintf(`
+resource "ciscosecureaccess_private_resource_group" "test" {
+    name = "%s"
+}
+
+resource "ciscosecureaccess_access_policy" "test_resource" {
+    name = "%s"
+    action = "allow"
+    enabled = true
+    log_level = "LOG_ALL"
+    source_types = ["networks"]
+    private_resource_group_ids = [ciscosecureaccess_private_resource_group.test.id]
+    description = "%s"
+}`, groupName, name, testAccessPolicyDescription)
+}
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+func TestPrivateResourceGroupConditionRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	plan := newAccessPolicyModel(1)
+	plan.PrivateResourceGroupIds = types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(5)})
+
+	// Round trip through JSON so the SDK decodes the condition name
+	body, err := json.Marshal(map[string]any{"ruleConditions": buildDestinationConditions(ctx, &plan)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rule rules.Rule
+	if err := json.Unmarshal(body, &rule); err != nil {
+		t.Fatalf("the SDK cannot decode the condition: %v", err)
+	}
+
+	parsed := newAccessPolicyModel(1)
+	if diags := parseAccessPolicyRule(ctx, &rule, &parsed); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !parsed.PrivateResourceGroupIds.Equal(plan.PrivateResourceGroupIds) {
+		t.Errorf("private_resource_group_ids = %s, want %s", parsed.PrivateResourceGroupIds, plan.PrivateResourceGroupIds)
+	}
+}
+
+// testRoundTripAccessPolicyRule sends plan through JSON the way the API
+// returns it and parses the resulting rule
+func testRoundTripAccessPolicyRule(t *testing.T, plan accessPolicyResourceModel) accessPolicyResourceModel {
+	t.Helper()
+	ctx := context.Background()
+	request := formatCreateAccessPolicyRequest(ctx, &plan)
+	body, err := json.Marshal(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rule rules.Rule
+	if err := json.Unmarshal(body, &rule); err != nil {
+		t.Fatalf("the SDK cannot decode the rule: %v", err)
+	}
+
+	parsed := newAccessPolicyModel(plan.ID.ValueInt64())
+	if diags := parseAccessPolicyRule(ctx, &rule, &parsed); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return parsed
+}
+
+func TestAccessPolicyInternetOptionsRoundTrip(t *testing.T) {
+	plan := newAccessPolicyModel(1)
+	plan.Name = types.StringValue("internet")
+	plan.Action = types.StringValue("allow")
+	plan.LogLevel = types.StringValue("LOG_ALL")
+	plan.TrafficType = types.StringValue("PUBLIC_INTERNET")
+	plan.ApplicationCategoryIds = types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(21), types.Int64Value(34)})
+
+	parsed := testRoundTripAccessPolicyRule(t, plan)
+	if !parsed.ApplicationCategoryIds.Equal(plan.ApplicationCategoryIds) {
+		t.Errorf("application_category_ids = %s, want %s", parsed.ApplicationCategoryIds, plan.ApplicationCategoryIds)
+	}
+	if !parsed.ReauthenticationMinutes.IsNull() {
+		t.Errorf("reauthentication_minutes = %s, want null", parsed.ReauthenticationMinutes)
+	}
+}
+
+func TestAccessPolicyReauthenticationRoundTrip(t *testing.T) {
+	plan := newAccessPolicyModel(1)
+	plan.Name = types.StringValue("private")
+	plan.Action = types.StringValue("allow")
+	plan.LogLevel = types.StringValue("LOG_ALL")
+	plan.TrafficType = types.StringValue("PRIVATE_NETWORK")
+	plan.PrivateDestinationTypes = types.SetValueMust(types.StringType, []attr.Value{types.StringValue(PRIVATE_APPS_SCHEMA)})
+	plan.ReauthenticationMinutes = types.Int64Value(480)
+
+	parsed := testRoundTripAccessPolicyRule(t, plan)
+	if !parsed.ReauthenticationMinutes.Equal(plan.ReauthenticationMinutes) {
+		t.Errorf("reauthentication_minutes = %s, want %s", parsed.ReauthenticationMinutes, plan.ReauthenticationMinutes)
+	}
+	if !parsed.PrivateDestinationTypes.Equal(plan.PrivateDestinationTypes) {
+		t.Errorf("private_destination_types = %s, want %s", parsed.PrivateDestinationTypes, plan.PrivateDestinationTypes)
+	}
+}
+
+func TestParseAccessPolicyRule_removedConditionsAndSettings(t *testing.T) {
+	var rule rules.Rule
+	body := `{
+		"ruleId": 1, "ruleName": "private", "ruleAction": "allow", "rulePriority": 3, "ruleIsEnabled": true,
+		"ruleConditions": [
+			{"attributeName": "umbrella.destination.private_resource_types", "attributeValue": ["apps"], "attributeOperator": "INTERSECT"},
+			{"attributeName": "umbrella.source.identity_type_ids", "attributeValue": [40], "attributeOperator": "INTERSECT"}
+		],
+		"ruleSettings": [
+			{"settingName": "umbrella.logLevel", "settingValue": "LOG_ALL"},
+			{"settingName": "umbrella.default.traffic", "settingValue": "PRIVATE_NETWORK"},
+			{"settingName": "sse.ztaAuthnTimeoutEnabled", "settingValue": false},
+			{"settingName": "sse.ztaAuthnTimeoutMinutes", "settingValue": 60}
+		]
+	}`
+	if err := json.Unmarshal([]byte(body), &rule); err != nil {
+		t.Fatalf("decoding rule: %v", err)
+	}
+
+	// State from before the destination list, profiles and description were
+	// removed in the dashboard
+	m := newAccessPolicyModel(1)
+	m.DestinationListIds = types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(7)})
+	m.SourceIds = types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(8)})
+	m.ClientPostureProfileId = types.Int64Value(9)
+	m.IpsProfileId = types.Int64Value(10)
+	m.ReauthenticationMinutes = types.Int64Value(60)
+	m.Description = types.StringValue("removed")
+
+	if diags := parseAccessPolicyRule(context.Background(), &rule, &m); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	for name, value := range map[string]attr.Value{
+		"destination_list_ids":      m.DestinationListIds,
+		"source_ids":                m.SourceIds,
+		"client_posture_profile_id": m.ClientPostureProfileId,
+		"ips_profile_id":            m.IpsProfileId,
+		"reauthentication_minutes":  m.ReauthenticationMinutes,
+		"description":               m.Description,
+	} {
+		if !value.IsNull() {
+			t.Errorf("%s = %s, want null after removal", name, value)
+		}
+	}
+	if m.ID.ValueInt64() != 1 || m.Priority.ValueInt64() != 3 || len(m.PrivateDestinationTypes.Elements()) != 1 || len(m.SourceTypes.Elements()) != 1 {
+		t.Errorf("rule not parsed: %+v", m)
+	}
+}
+
+func TestValidateAccessPolicyConfig(t *testing.T) {
+	valid := accessPolicyResourceSchemaModel{accessPolicyResourceModel: newAccessPolicyModel(1)}
+	valid.TrafficType = types.StringValue("PRIVATE_NETWORK")
+	valid.ReauthenticationMinutes = types.Int64Value(60)
+	if diags := validateAccessPolicyTrafficType(&valid.accessPolicyResourceModel); diags.HasError() {
+		t.Errorf("private rule with reauthentication: unexpected diagnostics %v", diags)
+	}
+
+	internet := valid
+	internet.TrafficType = types.StringValue("PUBLIC_INTERNET")
+	if diags := validateAccessPolicyTrafficType(&internet.accessPolicyResourceModel); diags.ErrorsCount() != 1 {
+		t.Errorf("internet rule with reauthentication: got %d errors, want 1", diags.ErrorsCount())
+	}
+}
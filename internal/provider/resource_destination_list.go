@@ -17,6 +17,7 @@ import (
 
 	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
 	"github.com/CiscoDevNet/go-ciscosecureaccess/destinationlists"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -33,6 +34,7 @@ import (
 
 var _ resource.Resource = (*destinationListResource)(nil)
 var _ resource.ResourceWithValidateConfig = (*destinationListResource)(nil)
+var _ resource.ResourceWithImportState = (*destinationListResource)(nil)
 
 // Constants for destination list resource
 const (
@@ -61,9 +63,10 @@ type destinationListResource struct {
 }
 
 type destinationListResourceModel struct {
-	Id           types.Int64  `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	Destinations types.Set    `tfsdk:"destinations"`
+	Id           types.Int64    `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	Destinations types.Set      `tfsdk:"destinations"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 // GetDestinations retrieves destinations for a destination list
@@ -130,6 +133,11 @@ func (r *destinationListResourceModel) UpdateDestinations(ctx context.Context, c
 		return resp
 	}
 
+	// Leave an unset destinations attribute unset when the list is empty
+	if len(readDestinations) == 0 && r.Destinations.IsNull() {
+		return resp
+	}
+
 	destinationListValue, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: destinationModel{}.AttrTypes()}, readDestinations)
 	if diags.HasError() {
 		resp.Append(diags...)
@@ -221,6 +229,9 @@ func (r *destinationListResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -390,9 +401,17 @@ func (r *destinationListResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create API call logic
 	var planDestinationList []destinationModel
-	diags := plan.Destinations.ElementsAs(ctx, &planDestinationList, true)
+	diags = plan.Destinations.ElementsAs(ctx, &planDestinationList, true)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -489,6 +508,14 @@ func (r *destinationListResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Read API call logic
 	destinationListResp, httpRes, err := r.client.DestinationListsAPI.GetDestinationList(ctx, data.Id.ValueInt64()).Execute()
 	if err != nil {
@@ -561,6 +588,37 @@ func (r *destinationListResource) Read(ctx context.Context, req resource.ReadReq
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ImportState imports an existing destination list by its numeric ID or name
+func (r *destinationListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := resolveImportID(ctx, req.ID, "destination list", r.findDestinationListsByName)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// findDestinationListsByName returns the IDs of all destination lists named name
+func (r *destinationListResource) findDestinationListsByName(ctx context.Context, name string) ([]int64, error) {
+	var ids []int64
+	for page := int64(1); ; page++ {
+		listsResp, _, err := r.client.DestinationListsAPI.GetDestinationLists(ctx).Page(page).Limit(importLookupPageLimit).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, list := range listsResp.Data {
+			if list.Name == name {
+				ids = append(ids, list.Id)
+			}
+		}
+
+		if len(listsResp.Data) < importLookupPageLimit {
+			return ids, nil
+		}
+	}
+}
+
 // Update updates the destination list resource
 func (r *destinationListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Read Terraform plan data into the model
@@ -571,6 +629,14 @@ func (r *destinationListResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Update API call logic
 	if !plan.Name.Equal(state.Name) {
 		payload := destinationlists.DestinationListPatch{Name: plan.Name.ValueString()}
@@ -595,7 +661,7 @@ func (r *destinationListResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	var planDestinationList []destinationModel
-	diags := plan.Destinations.ElementsAs(ctx, &planDestinationList, true)
+	diags = plan.Destinations.ElementsAs(ctx, &planDestinationList, true)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -720,6 +786,14 @@ func (r *destinationListResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete API call logic
 	deleteResp, httpRes, err := r.client.DestinationListsAPI.DeleteDestinationList(ctx, data.Id.ValueInt64()).Execute()
 	if err != nil {
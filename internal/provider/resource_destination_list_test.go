@@ -42,6 +42,17 @@ func TestAccDestinationList_basic(t *testing.T) {
 						statecheck.ExpectKnownValue(testDestinationListResourceName, tfjsonpath.New("destinations"), knownvalue.SetSizeExact(2)),
 					},
 				},
+				{
+					ResourceName:      testDestinationListResourceName,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					ResourceName:      testDestinationListResourceName,
+					ImportState:       true,
+					ImportStateIdFunc: testAccImportStateIDByName(testDestinationListResourceName),
+					ImportStateVerify: true,
+				},
 			},
 		})
 	}, minWaitTime)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
 	"github.com/CiscoDevNet/go-ciscosecureaccess/rules"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -41,9 +42,10 @@ type globalSettingsResource struct {
 
 // globalSettingsResourceModel represents the Terraform resource data model
 type globalSettingsResourceModel struct {
-	Id                     types.String `tfsdk:"id"`
-	EnableGlobalDecryption types.Bool   `tfsdk:"enable_global_decryption"`
-	GlobalIPSProfileId     types.Int64  `tfsdk:"global_ips_profile_id"`
+	Id                     types.String   `tfsdk:"id"`
+	EnableGlobalDecryption types.Bool     `tfsdk:"enable_global_decryption"`
+	GlobalIPSProfileId     types.Int64    `tfsdk:"global_ips_profile_id"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Configure adds the provider configured client to the resource
@@ -89,6 +91,9 @@ func (r *globalSettingsResource) Schema(ctx context.Context, req resource.Schema
 				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -102,6 +107,14 @@ func (r *globalSettingsResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Creating global settings resource", map[string]interface{}{
 		"enable_global_decryption": plan.EnableGlobalDecryption.ValueBool(),
 		"global_ips_profile_id":    plan.GlobalIPSProfileId.ValueInt64(),
@@ -109,7 +122,7 @@ func (r *globalSettingsResource) Create(ctx context.Context, req resource.Create
 
 	// Fetch current state from API
 	var currentState globalSettingsResourceModel
-	diags := r.FetchState(ctx, &currentState)
+	diags = r.FetchState(ctx, &currentState)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -266,10 +279,18 @@ func (r *globalSettingsResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Reading global settings resource state")
 
 	// Read API call logic
-	diags := r.FetchState(ctx, &data)
+	diags = r.FetchState(ctx, &data)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -298,6 +319,14 @@ func (r *globalSettingsResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Updating global settings resource", map[string]interface{}{
 		"plan_enable_global_decryption":  plan.EnableGlobalDecryption.ValueBool(),
 		"plan_global_ips_profile_id":     plan.GlobalIPSProfileId.ValueInt64(),
@@ -306,7 +335,7 @@ func (r *globalSettingsResource) Update(ctx context.Context, req resource.Update
 	})
 
 	// Update API call logic
-	diags := r.PutState(ctx, &state, &plan)
+	diags = r.PutState(ctx, &state, &plan)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -44,14 +45,15 @@ type internalDomainResource struct {
 
 // internalDomainResourceModel maps the data schema data.
 type internalDomainResourceModel struct {
-	Id                      types.Int64  `tfsdk:"id"`
-	Domain                  types.String `tfsdk:"domain"`
-	Description             types.String `tfsdk:"description"`
-	IncludeAllVAs           types.Bool   `tfsdk:"include_all_vas"`
-	IncludeAllMobileDevices types.Bool   `tfsdk:"include_all_mobile_devices"`
-	SiteIds                 types.List   `tfsdk:"site_ids"`
-	CreatedAt               types.String `tfsdk:"created_at"`
-	ModifiedAt              types.String `tfsdk:"modified_at"`
+	Id                      types.Int64    `tfsdk:"id"`
+	Domain                  types.String   `tfsdk:"domain"`
+	Description             types.String   `tfsdk:"description"`
+	IncludeAllVAs           types.Bool     `tfsdk:"include_all_vas"`
+	IncludeAllMobileDevices types.Bool     `tfsdk:"include_all_mobile_devices"`
+	SiteIds                 types.List     `tfsdk:"site_ids"`
+	CreatedAt               types.String   `tfsdk:"created_at"`
+	ModifiedAt              types.String   `tfsdk:"modified_at"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -77,7 +79,7 @@ func (r *internalDomainResource) Configure(ctx context.Context, req resource.Con
 }
 
 // Schema defines the schema for the resource.
-func (r *internalDomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *internalDomainResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "Manages a Cisco Secure Access Internal Domain resource. Internal domains define which DNS domains are resolved internally via the Secure Access tunnel.",
 		MarkdownDescription: "Manages a Cisco Secure Access Internal Domain resource. Internal domains define which DNS domains are resolved internally via the Secure Access tunnel.",
@@ -134,6 +136,9 @@ func (r *internalDomainResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -148,6 +153,14 @@ func (r *internalDomainResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	if planRep, err := json.Marshal(plan); err == nil {
 		tflog.Debug(ctx, "Local internal domain definition", map[string]interface{}{"definition": string(planRep)})
 	}
@@ -190,6 +203,14 @@ func (r *internalDomainResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	internalDomainId := state.Id.ValueInt64()
 	tflog.Debug(ctx, "Reading internal domain", map[string]interface{}{"id": internalDomainId})
 
@@ -239,6 +260,14 @@ func (r *internalDomainResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	internalDomainId := plan.Id.ValueInt64()
 	updateInternalDomainRequest := buildInternalDomainRequest(ctx, plan, resp.Diagnostics.AddError)
 	if resp.Diagnostics.HasError() {
@@ -260,6 +289,7 @@ func (r *internalDomainResource) Update(ctx context.Context, req resource.Update
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.Timeouts = plan.Timeouts
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -273,6 +303,14 @@ func (r *internalDomainResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	internalDomainId := state.Id.ValueInt64()
 	tflog.Info(ctx, "Deleting internal domain", map[string]interface{}{"id": internalDomainId})
 
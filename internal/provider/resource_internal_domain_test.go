@@ -7,7 +7,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"os"
 	"testing"
 	"time"
 
@@ -20,7 +19,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
-	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
 	"github.com/CiscoDevNet/go-ciscosecureaccess/internaldomains"
 )
 
@@ -294,10 +292,7 @@ func TestInternalDomainResource_update(t *testing.T) {
 
 func testAccCheckInternalDomainDestroy(s *terraform.State) error {
 	ctx := context.Background()
-	factory := &client.SSEClientFactory{
-		KeyId:     os.Getenv("CISCOSECUREACCESS_KEY_ID"),
-		KeySecret: os.Getenv("CISCOSECUREACCESS_KEY_SECRET"),
-	}
+	factory := testAccClientFactory()
 	c := factory.GetInternalDomainsClient(ctx)
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ciscosecureaccess_internal_domain" {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
 	"github.com/CiscoDevNet/go-ciscosecureaccess/internalnetworks"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,8 +25,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &internalNetworkResource{}
-	_ resource.ResourceWithConfigure = &internalNetworkResource{}
+	_ resource.Resource                = &internalNetworkResource{}
+	_ resource.ResourceWithConfigure   = &internalNetworkResource{}
+	_ resource.ResourceWithImportState = &internalNetworkResource{}
 )
 
 // NewInternalNetworkResource is a helper function to simplify the provider implementation.
@@ -40,16 +42,17 @@ type internalNetworkResource struct {
 
 // internalNetworkResourceModel maps the data schema data.
 type internalNetworkResourceModel struct {
-	Id           types.Int64  `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	IpAddress    types.String `tfsdk:"ip_address"`
-	PrefixLength types.Int64  `tfsdk:"prefix_length"`
-	SiteId       types.Int64  `tfsdk:"site_id"`
-	NetworkId    types.Int64  `tfsdk:"network_id"`
-	TunnelId     types.Int64  `tfsdk:"tunnel_id"`
-	SiteName     types.String `tfsdk:"site_name"`
-	NetworkName  types.String `tfsdk:"network_name"`
-	TunnelName   types.String `tfsdk:"tunnel_name"`
+	Id           types.Int64    `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	IpAddress    types.String   `tfsdk:"ip_address"`
+	PrefixLength types.Int64    `tfsdk:"prefix_length"`
+	SiteId       types.Int64    `tfsdk:"site_id"`
+	NetworkId    types.Int64    `tfsdk:"network_id"`
+	TunnelId     types.Int64    `tfsdk:"tunnel_id"`
+	SiteName     types.String   `tfsdk:"site_name"`
+	NetworkName  types.String   `tfsdk:"network_name"`
+	TunnelName   types.String   `tfsdk:"tunnel_name"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -73,7 +76,7 @@ func (r *internalNetworkResource) Configure(ctx context.Context, req resource.Co
 }
 
 // Schema defines the schema for the resource.
-func (r *internalNetworkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *internalNetworkResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages an Internal Network in the Cisco Secure Access organization. Specify one of: site_id, network_id, or tunnel_id.",
 		Attributes: map[string]schema.Attribute{
@@ -144,6 +147,9 @@ func (r *internalNetworkResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -157,6 +163,14 @@ func (r *internalNetworkResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	createRequest := *internalnetworks.NewCreateInternalNetworkRequest(
 		plan.Name.ValueString(),
 		plan.IpAddress.ValueString(),
@@ -203,6 +217,14 @@ func (r *internalNetworkResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	networkId := state.Id.ValueInt64()
 	tflog.Debug(ctx, "Reading internal network", map[string]interface{}{"id": networkId})
 
@@ -236,6 +258,14 @@ func (r *internalNetworkResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	networkId := state.Id.ValueInt64()
 	plan.Id = state.Id
 
@@ -282,6 +312,14 @@ func (r *internalNetworkResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	networkId := state.Id.ValueInt64()
 	tflog.Debug(ctx, "Deleting internal network", map[string]interface{}{"id": networkId})
 
@@ -300,6 +338,37 @@ func (r *internalNetworkResource) Delete(ctx context.Context, req resource.Delet
 	tflog.Debug(ctx, "Deleted internal network", map[string]interface{}{"id": networkId})
 }
 
+// ImportState imports an existing Internal Network by its numeric origin ID or name.
+func (r *internalNetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := resolveImportID(ctx, req.ID, "internal network", r.findInternalNetworksByName)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// findInternalNetworksByName returns the origin IDs of all internal networks named name.
+func (r *internalNetworkResource) findInternalNetworksByName(ctx context.Context, name string) ([]int64, error) {
+	var ids []int64
+	for page := int64(1); ; page++ {
+		networks, _, err := r.client.InternalNetworksAPI.ListInternalNetworks(ctx).Page(page).Limit(importLookupPageLimit).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, network := range networks {
+			if network.GetName() == name {
+				ids = append(ids, network.GetOriginId())
+			}
+		}
+
+		if len(networks) < importLookupPageLimit {
+			return ids, nil
+		}
+	}
+}
+
 // flattenInternalNetworkObject maps API response fields to the Terraform state model.
 func flattenInternalNetworkObject(network *internalnetworks.InternalNetworkObject, model *internalNetworkResourceModel) {
 	model.Id = types.Int64Value(network.GetOriginId())
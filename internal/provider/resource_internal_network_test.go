@@ -7,10 +7,8 @@ package provider
 import (
 	"context"
 	"fmt"
-	"os"
 	"testing"
 
-	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
 	"github.com/CiscoDevNet/go-ciscosecureaccess/internalnetworks"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -198,6 +196,17 @@ func TestInternalNetworkResource_basic(t *testing.T) {
 						statecheck.ExpectKnownValue(testInternalNetworkResourceName, tfjsonpath.New("prefix_length"), knownvalue.Int64Exact(testInternalNetworkPrefixLength)),
 					},
 				},
+				{
+					ResourceName:      testInternalNetworkResourceName,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					ResourceName:      testInternalNetworkResourceName,
+					ImportState:       true,
+					ImportStateIdFunc: testAccImportStateIDByName(testInternalNetworkResourceName),
+					ImportStateVerify: true,
+				},
 			},
 		})
 	}, minWaitTime)
@@ -252,10 +261,7 @@ resource "ciscosecureaccess_internal_network" "test_resource" {
 
 func testAccCheckInternalNetworkDestroy(s *terraform.State) error {
 	ctx := context.Background()
-	factory := &client.SSEClientFactory{
-		KeyId:     os.Getenv("CISCOSECUREACCESS_KEY_ID"),
-		KeySecret: os.Getenv("CISCOSECUREACCESS_KEY_SECRET"),
-	}
+	factory := testAccClientFactory()
 	c := factory.GetInternalNetworksClient(ctx)
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ciscosecureaccess_internal_network" {
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -41,13 +42,14 @@ type networkResource struct {
 }
 
 type networkResourceModel struct {
-	Id           types.Int64  `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	IpAddress    types.String `tfsdk:"ip_address"`
-	PrefixLength types.Int64  `tfsdk:"prefix_length"`
-	IsDynamic    types.Bool   `tfsdk:"is_dynamic"`
-	Status       types.String `tfsdk:"status"`
-	CreatedAt    types.String `tfsdk:"created_at"`
+	Id           types.Int64    `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	IpAddress    types.String   `tfsdk:"ip_address"`
+	PrefixLength types.Int64    `tfsdk:"prefix_length"`
+	IsDynamic    types.Bool     `tfsdk:"is_dynamic"`
+	Status       types.String   `tfsdk:"status"`
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *networkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -70,7 +72,7 @@ func (r *networkResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = *factory.GetNetworksClient(ctx)
 }
 
-func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *networkResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version:             0,
 		Description:         "Manages a Cisco Secure Access Network resource.",
@@ -120,6 +122,9 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -145,6 +150,14 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	if planRep, err := json.Marshal(plan); err == nil {
 		tflog.Debug(ctx, "Local network definition", map[string]interface{}{"definition": string(planRep)})
 	}
@@ -178,6 +191,14 @@ func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	networkId := state.Id.ValueInt64()
 	tflog.Debug(ctx, "Reading network", map[string]interface{}{"id": networkId})
 
@@ -222,6 +243,14 @@ func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	networkId := plan.Id.ValueInt64()
 	updateNetworkRequest := *networks.NewUpdateNetworkRequest(plan.Name.ValueString(), plan.IsDynamic.ValueBool(), plan.Status.ValueString())
 	updateNetworkRequest.SetPrefixLength(plan.PrefixLength.ValueInt64())
@@ -249,6 +278,7 @@ func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest
 		state.IsDynamic = plan.IsDynamic
 		state.Status = plan.Status
 	}
+	state.Timeouts = plan.Timeouts
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -261,6 +291,14 @@ func (r *networkResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	networkId := state.Id.ValueInt64()
 	tflog.Info(ctx, "Deleting network", map[string]interface{}{"id": networkId})
 
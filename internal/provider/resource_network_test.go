@@ -11,7 +11,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
 	"github.com/CiscoDevNet/go-ciscosecureaccess/networks"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -165,10 +164,7 @@ func TestNetworkResource_update(t *testing.T) {
 
 func testAccCheckNetworkDestroy(s *terraform.State) error {
 	ctx := context.Background()
-	factory := &client.SSEClientFactory{
-		KeyId:     os.Getenv("CISCOSECUREACCESS_KEY_ID"),
-		KeySecret: os.Getenv("CISCOSECUREACCESS_KEY_SECRET"),
-	}
+	factory := testAccClientFactory()
 	c := factory.GetNetworksClient(ctx)
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ciscosecureaccess_network" {
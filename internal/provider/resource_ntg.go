@@ -8,13 +8,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -25,10 +34,40 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &networkTunnelGroupResource{}
-	_ resource.ResourceWithConfigure = &networkTunnelGroupResource{}
+	_ resource.Resource                = &networkTunnelGroupResource{}
+	_ resource.ResourceWithConfigure   = &networkTunnelGroupResource{}
+	_ resource.ResourceWithImportState = &networkTunnelGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &networkTunnelGroupResource{}
+
+	_ resource.ResourceWithConfigValidators = &networkTunnelGroupResource{}
+	_ resource.ResourceWithValidateConfig   = &networkTunnelGroupResource{}
+)
+
+// Routing types of network tunnel groups
+const (
+	ntgRoutingStatic = "static"
+	ntgRoutingBGP    = "bgp"
+	ntgRoutingNAT    = "nat"
 )
 
+// ntgImmutableAttributes explains, for each attribute the API cannot change
+// on an existing network tunnel group, what replacing the group changes.
+var ntgImmutableAttributes = map[string]string{
+	"region":            "The hubs move to data centers of the new region, so their IP addresses change.",
+	"identifier_prefix": "The tunnel authentication IDs of the hubs are derived from the prefix and change with it.",
+	"device_type":       "The device type is only set when the tunnel group is created.",
+}
+
+// ntgDeviceTypes returns the device types the API accepts for network tunnel
+// groups
+func ntgDeviceTypes() []string {
+	deviceTypes := make([]string, 0, len(ntg.AllowedDeviceTypeEnumValues))
+	for _, deviceType := range ntg.AllowedDeviceTypeEnumValues {
+		deviceTypes = append(deviceTypes, string(deviceType))
+	}
+	return deviceTypes
+}
+
 // NewNetworkTunnelGroupResource is a helper function to simplify the provider implementation.
 func NewNetworkTunnelGroupResource() resource.Resource {
 	return &networkTunnelGroupResource{}
@@ -41,14 +80,44 @@ type networkTunnelGroupResource struct {
 
 // ntgResourceModel maps the data schema data.
 type ntgResourceModel struct {
-	Id               types.Int64    `tfsdk:"id"`
-	NetworkCidrs     []types.String `tfsdk:"network_cidrs"`
-	Name             types.String   `tfsdk:"name"`
-	Region           types.String   `tfsdk:"region"`
-	IdentifierPrefix types.String   `tfsdk:"identifier_prefix"`
-	PresharedKey     types.String   `tfsdk:"preshared_key"`
-	DeviceType       types.String   `tfsdk:"device_type"`
-	Hubs             types.List     `tfsdk:"hubs"`
+	Id                  types.Int64      `tfsdk:"id"`
+	NetworkCidrs        []types.String   `tfsdk:"network_cidrs"`
+	Name                types.String     `tfsdk:"name"`
+	Region              types.String     `tfsdk:"region"`
+	IdentifierPrefix    types.String     `tfsdk:"identifier_prefix"`
+	// PresharedKey is write-only: it is always null here and the key is
+	// read from the configuration
+	PresharedKey        types.String     `tfsdk:"preshared_key"`
+	PresharedKeyVersion types.Int64      `tfsdk:"preshared_key_version"`
+	DeviceType          types.String     `tfsdk:"device_type"`
+	Hubs                types.List       `tfsdk:"hubs"`
+	Routing             *ntgRoutingModel `tfsdk:"routing"`
+	Timeouts            timeouts.Value   `tfsdk:"timeouts"`
+}
+
+// ntgPlanModel maps the resource like ntgResourceModel, but reads
+// network_cidrs and routing as a list and an object, which unlike the slice
+// and struct of ntgResourceModel can hold unknown values while planning, for
+// example CIDRs computed by another resource.
+type ntgPlanModel struct {
+	Id                  types.Int64    `tfsdk:"id"`
+	NetworkCidrs        types.List     `tfsdk:"network_cidrs"`
+	Name                types.String   `tfsdk:"name"`
+	Region              types.String   `tfsdk:"region"`
+	IdentifierPrefix    types.String   `tfsdk:"identifier_prefix"`
+	PresharedKey        types.String   `tfsdk:"preshared_key"`
+	PresharedKeyVersion types.Int64    `tfsdk:"preshared_key_version"`
+	DeviceType          types.String   `tfsdk:"device_type"`
+	Hubs                types.List     `tfsdk:"hubs"`
+	Routing             types.Object   `tfsdk:"routing"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ntgRoutingModel maps the routing attribute.
+type ntgRoutingModel struct {
+	Type         types.String `tfsdk:"type"`
+	NetworkCidrs types.List   `tfsdk:"network_cidrs"`
+	AsNumber     types.String `tfsdk:"as_number"`
 }
 
 type hubModel struct {
@@ -100,10 +169,9 @@ func (r *networkTunnelGroupResource) Configure(ctx context.Context, req resource
 }
 
 // Schema defines the schema for the resource.
-func (r *networkTunnelGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *networkTunnelGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		//TODO: BGP support
-		Description: "Cisco Secure Access Network Tunnel Group resource, currently supports static routes only",
+		Description: "Cisco Secure Access Network Tunnel Group resource",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "Unique ID of network tunnel group",
@@ -113,37 +181,80 @@ func (r *networkTunnelGroupResource) Schema(_ context.Context, _ resource.Schema
 				},
 			},
 			"network_cidrs": schema.ListAttribute{
-				Description: "Inside Network CIDR addresses of network tunnel group",
-				Optional:    true,
-				ElementType: types.StringType,
+				Description:        "Inside Network CIDR addresses of network tunnel group, routed statically",
+				Optional:           true,
+				ElementType:        types.StringType,
+				DeprecationMessage: "Use routing with type static and network_cidrs instead.",
+			},
+			"routing": schema.SingleNestedAttribute{
+				Description: "How traffic is routed to the networks behind the tunnels: static routes to network_cidrs, " +
+					"routes learned over BGP from the device with AS number as_number, or nat for networks with overlapping address spaces",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Routing type: static, bgp or nat",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(ntgRoutingStatic, ntgRoutingBGP, ntgRoutingNAT),
+						},
+					},
+					"network_cidrs": schema.ListAttribute{
+						Description: "Inside network CIDR addresses routed to the tunnels. Required for static routing.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"as_number": schema.StringAttribute{
+						Description: "Autonomous system (AS) number of the device peering with Secure Access. Required for bgp routing.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(ntgASNumberPattern, "must be a decimal AS number"),
+						},
+					},
+				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of network tunnel group",
+				Description: "Name of network tunnel group. Changing it updates the network tunnel group in place.",
 				Required:    true,
 			},
 			"region": schema.StringAttribute{
-				Description:   "Deployment region of network tunnel group",
+				Description:   "Deployment region of network tunnel group, one of the regions listed by the ciscosecureaccess_regions data source. Changing it replaces the network tunnel group.",
 				Required:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"identifier_prefix": schema.StringAttribute{
-				Description:   "Prefix for tunnel authentication ID",
+				Description:   "Prefix for tunnel authentication ID. Changing it replaces the network tunnel group.",
 				Required:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"preshared_key": schema.StringAttribute{
-				Description: "Secret preshared key used to authenticate network tunnel group",
-				Sensitive:   true,
-				Required:    true,
+				Description: "Secret preshared key used to authenticate network tunnel group: 16 to 64 letters and digits, with at least one upper case letter, " +
+					"one lower case letter and one digit. Required on create. Write-only: it is never stored in the plan or state, and only written to " +
+					"an existing tunnel group when preshared_key_version changes. Requires Terraform 1.11 or later.",
+				Sensitive:  true,
+				Optional:   true,
+				WriteOnly:  true,
+				Validators: presharedKeyValidators(),
+			},
+			"preshared_key_version": schema.Int64Attribute{
+				Description: "Arbitrary number that writes preshared_key to the network tunnel group whenever it changes, to rotate " +
+					"the key or to restore it after it was changed outside Terraform. The tunnel group is updated in place.",
+				Optional: true,
 			},
 			"device_type": schema.StringAttribute{
-				Description: "Type of device used to terminate network tunnel group",
-				Required:    true,
-				//TODO: Input validation
+				Description:   "Type of device used to terminate network tunnel group, one of: " + strings.Join(ntgDeviceTypes(), ", ") + ". Changing it replaces the network tunnel group.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ntgDeviceTypes()...),
+				},
 			},
 			"hubs": schema.ListNestedAttribute{
 				Description: "Remote connection endpoints for connecting network tunnel group",
 				Computed:    true,
+				// In-place updates keep the hubs; replacements plan new ones
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.Int64Attribute{
@@ -176,9 +287,158 @@ func (r *networkTunnelGroupResource) Schema(_ context.Context, _ resource.Schema
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// ModifyPlan checks new regions against the regions API, and warns about
+// changes that replace the network tunnel group, since replacing it brings
+// down the tunnels of every device connected to it. Name, routing and
+// preshared key changes are applied in place by Update.
+func (r *networkTunnelGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ntgPlanModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Region.IsUnknown() && !plan.Region.Equal(state.Region) {
+		resp.Diagnostics.Append(r.validateRegion(ctx, plan.Region.ValueString())...)
+	}
+	if req.State.Raw.IsNull() {
+		// The key is taken from the configuration, as it cannot be read back
+		var presharedKey types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("preshared_key"), &presharedKey)...)
+		if presharedKey.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("preshared_key"),
+				"Missing Preshared Key",
+				"preshared_key must be configured to create a network tunnel group.",
+			)
+		}
+		return
+	}
+
+	for _, attribute := range ntgReplacedAttributes(plan, state) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root(attribute),
+			"Network Tunnel Group Will Be Replaced",
+			fmt.Sprintf("Changing %s replaces network tunnel group %q (ID %d), which cannot be changed in place. %s "+
+				"The tunnels of every device connected to the group go down until the devices are reconfigured with "+
+				"the hubs of the new tunnel group.", attribute, state.Name.ValueString(), state.Id.ValueInt64(), ntgImmutableAttributes[attribute]),
+		)
+	}
+}
+
+// validateRegion checks that region accepts new network tunnel groups. The
+// check is skipped with a warning when the regions cannot be listed, so the
+// API has the final say.
+func (r *networkTunnelGroupResource) validateRegion(ctx context.Context, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.client.NetworkTunnelGroupsRegionsAPI == nil {
+		return diags
+	}
+
+	status := ntgRegionsAvailable
+	regionList, err := listNTGRegions(ctx, r.client, ntg.FiltersRegionsObject{Status: &status})
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("region"),
+			"Region Not Validated",
+			fmt.Sprintf("Could not list network tunnel group regions to validate region %q: %s", region, err.Error()),
+		)
+		return diags
+	}
+	return checkNTGRegion(region, regionList.Regions)
+}
+
+// ntgReplacedAttributes returns the immutable attributes that plan changes,
+// in schema order. Unknown values count as changes, since they replace the
+// tunnel group as well.
+func ntgReplacedAttributes(plan, state ntgPlanModel) []string {
+	var replaced []string
+	for _, attribute := range []struct {
+		name        string
+		plan, state types.String
+	}{
+		{"region", plan.Region, state.Region},
+		{"identifier_prefix", plan.IdentifierPrefix, state.IdentifierPrefix},
+		{"device_type", plan.DeviceType, state.DeviceType},
+	} {
+		if !attribute.plan.Equal(attribute.state) {
+			replaced = append(replaced, attribute.name)
+		}
+	}
+	return replaced
+}
+
+func (r *networkTunnelGroupResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("network_cidrs"),
+			path.MatchRoot("routing"),
+		),
 	}
 }
 
+// ValidateConfig checks that routing only sets the attributes of its type.
+func (r *networkTunnelGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var routingType, asNumber types.String
+	var networkCidrs types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("routing").AtName("type"), &routingType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("routing").AtName("network_cidrs"), &networkCidrs)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("routing").AtName("as_number"), &asNumber)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateNTGRouting(routingType, networkCidrs, asNumber)...)
+}
+
+// validateNTGRouting requires network_cidrs for static routing and as_number
+// for bgp routing, and rejects the attributes other routing types ignore
+func validateNTGRouting(routingType types.String, networkCidrs types.List, asNumber types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if routingType.IsNull() || routingType.IsUnknown() {
+		return diags
+	}
+
+	cidrsPath := path.Root("routing").AtName("network_cidrs")
+	asNumberPath := path.Root("routing").AtName("as_number")
+	switch routingType.ValueString() {
+	case ntgRoutingStatic:
+		if networkCidrs.IsNull() {
+			diags.AddAttributeError(cidrsPath, "Missing Network CIDRs", "static routing requires network_cidrs")
+		}
+		if !asNumber.IsNull() {
+			diags.AddAttributeError(asNumberPath, "Invalid Attribute Combination", "as_number is only valid for bgp routing")
+		}
+	case ntgRoutingBGP:
+		if asNumber.IsNull() {
+			diags.AddAttributeError(asNumberPath, "Missing AS Number", "bgp routing requires as_number")
+		}
+		if !networkCidrs.IsNull() {
+			diags.AddAttributeError(cidrsPath, "Invalid Attribute Combination", "network_cidrs is only valid for static routing; BGP learns routes from the device")
+		}
+	case ntgRoutingNAT:
+		if !networkCidrs.IsNull() {
+			diags.AddAttributeError(cidrsPath, "Invalid Attribute Combination", "network_cidrs is only valid for static routing")
+		}
+		if !asNumber.IsNull() {
+			diags.AddAttributeError(asNumberPath, "Invalid Attribute Combination", "as_number is only valid for bgp routing")
+		}
+	}
+	return diags
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *networkTunnelGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Info(ctx, "Creating Network Tunnel Group")
@@ -190,6 +450,14 @@ func (r *networkTunnelGroupResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	planRep, _ := json.Marshal(plan)
 	tflog.Debug(ctx, "Local tunnel definition", map[string]interface{}{"definition": string(planRep)})
 
@@ -197,14 +465,22 @@ func (r *networkTunnelGroupResource) Create(ctx context.Context, req resource.Cr
 
 	tunnelIdentifier := plan.IdentifierPrefix.ValueString()
 	name := plan.Name.ValueString()
-	routeList := convertNetworkCidrsToStrings(plan.NetworkCidrs)
 	region := plan.Region.ValueString()
-	presharedKey := plan.PresharedKey.ValueString()
+	var configKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("preshared_key"), &configKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	presharedKey := configKey.ValueString()
 	devTypeDescription := plan.DeviceType.ValueString()
 
 	addNetworkTunnelGroupRequest := *ntg.NewAddNetworkTunnelGroupRequest(name, region, ntg.StringAsAddNetworkTunnelGroupRequestAuthIdPrefix(&tunnelIdentifier), presharedKey)
-	staticRoute := ntg.RoutingRequest{Type: "static", Data: ntg.RoutingRequestData{StaticDataRequestObj: &ntg.StaticDataRequestObj{NetworkCIDRs: routeList}}}
-	addNetworkTunnelGroupRequest.SetRouting(staticRoute)
+	routing, diags := ntgRoutingRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	addNetworkTunnelGroupRequest.SetRouting(routing)
 	deviceType, err := ntg.NewDeviceTypeFromValue(devTypeDescription)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -270,6 +546,14 @@ func (r *networkTunnelGroupResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tunnelId := state.Id.ValueInt64()
 	tflog.Debug(ctx, "Reading network tunnel group", map[string]interface{}{"id": tunnelId})
 
@@ -298,9 +582,20 @@ func (r *networkTunnelGroupResource) Read(ctx context.Context, req resource.Read
 
 	state.Name = types.StringValue(*readResp.Name)
 	state.Region = types.StringValue(*readResp.Region)
-	state.NetworkCidrs = convertStringsToNetworkCidrs(readResp.Routing.Data.StaticDataResponseObj.NetworkCIDRs)
+	resp.Diagnostics.Append(setNTGRoutingState(ctx, readResp.Routing, &state)...)
 	state.DeviceType = types.StringValue(string(*readResp.DeviceType))
 
+	// The auth ID prefix is not returned by the API, but every hub auth ID
+	// starts with it
+	if state.IdentifierPrefix.IsNull() {
+		for _, hub := range readResp.Hubs {
+			if prefix, _, found := strings.Cut(hub.GetAuthId(), "@"); found {
+				state.IdentifierPrefix = types.StringValue(prefix)
+				break
+			}
+		}
+	}
+
 	// Convert API hubs to terraform models
 	var hubs []hubModel
 	for _, hub := range readResp.Hubs {
@@ -363,8 +658,14 @@ func convertNetworkCidrsToStrings(cidrs []types.String) []string {
 	return result
 }
 
+// ntgASNumberPattern matches decimal autonomous system numbers
+var ntgASNumberPattern = regexp.MustCompile(`^[0-9]+$`)
+
 // convertStringsToNetworkCidrs converts string slice to terraform string values
 func convertStringsToNetworkCidrs(cidrs []string) []basetypes.StringValue {
+	if len(cidrs) == 0 {
+		return nil
+	}
 	result := make([]basetypes.StringValue, len(cidrs))
 	for i, cidr := range cidrs {
 		result[i] = types.StringValue(cidr)
@@ -372,6 +673,90 @@ func convertStringsToNetworkCidrs(cidrs []string) []basetypes.StringValue {
 	return result
 }
 
+// ntgRoutingRequest returns the routing of plan. Without a routing attribute,
+// the top-level network_cidrs are routed statically.
+func ntgRoutingRequest(ctx context.Context, plan ntgResourceModel) (ntg.RoutingRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if plan.Routing == nil {
+		routeList := convertNetworkCidrsToStrings(plan.NetworkCidrs)
+		return ntg.RoutingRequest{Type: ntgRoutingStatic, Data: ntg.StaticDataRequestObjAsRoutingRequestData(ntg.NewStaticDataRequestObj(routeList))}, diags
+	}
+
+	switch routingType := plan.Routing.Type.ValueString(); routingType {
+	case ntgRoutingBGP:
+		data := ntg.NewBgpDataRequestObj(plan.Routing.AsNumber.ValueString())
+		return ntg.RoutingRequest{Type: routingType, Data: ntg.BgpDataRequestObjAsRoutingRequestData(data)}, diags
+	case ntgRoutingNAT:
+		// NAT routing takes no data
+		empty := ""
+		return ntg.RoutingRequest{Type: routingType, Data: ntg.StringAsRoutingRequestData(&empty)}, diags
+	case ntgRoutingStatic:
+		routeList := []string{}
+		diags.Append(plan.Routing.NetworkCidrs.ElementsAs(ctx, &routeList, false)...)
+		return ntg.RoutingRequest{Type: routingType, Data: ntg.StaticDataRequestObjAsRoutingRequestData(ntg.NewStaticDataRequestObj(routeList))}, diags
+	default:
+		diags.AddAttributeError(
+			path.Root("routing").AtName("type"),
+			"Invalid Routing Type",
+			fmt.Sprintf("Routing type %q is not supported, it must be one of: %s, %s, %s.", routingType, ntgRoutingStatic, ntgRoutingBGP, ntgRoutingNAT),
+		)
+		return ntg.RoutingRequest{}, diags
+	}
+}
+
+// ntgRoutingChanged reports whether plan routes differently than state
+func ntgRoutingChanged(ctx context.Context, plan, state ntgResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if (plan.Routing == nil) != (state.Routing == nil) {
+		return true, diags
+	}
+	if plan.Routing == nil {
+		return !compareStringSlicesAsSets(state.NetworkCidrs, plan.NetworkCidrs), diags
+	}
+
+	var planCidrs, stateCidrs []types.String
+	diags.Append(plan.Routing.NetworkCidrs.ElementsAs(ctx, &planCidrs, false)...)
+	diags.Append(state.Routing.NetworkCidrs.ElementsAs(ctx, &stateCidrs, false)...)
+	return !plan.Routing.Type.Equal(state.Routing.Type) ||
+		!plan.Routing.AsNumber.Equal(state.Routing.AsNumber) ||
+		!compareStringSlicesAsSets(stateCidrs, planCidrs), diags
+}
+
+// setNTGRoutingState reconciles state with the routing returned by the API.
+// Static routes stay in the top-level network_cidrs when the prior state used
+// it; otherwise, including after an import, the routing is written to routing
+// so that any difference shows in the plan.
+func setNTGRoutingState(ctx context.Context, routing *ntg.RoutingResponse, state *ntgResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if routing == nil || routing.GetType() == "" {
+		return diags
+	}
+
+	routingType := routing.GetType()
+	var cidrs []string
+	if routing.Data.StaticDataResponseObj != nil {
+		cidrs = routing.Data.StaticDataResponseObj.NetworkCIDRs
+	}
+	if state.Routing == nil && state.NetworkCidrs != nil && routingType == ntgRoutingStatic {
+		state.NetworkCidrs = convertStringsToNetworkCidrs(cidrs)
+		return diags
+	}
+
+	state.NetworkCidrs = nil
+	state.Routing = &ntgRoutingModel{
+		Type:         types.StringValue(routingType),
+		NetworkCidrs: types.ListNull(types.StringType),
+		AsNumber:     types.StringNull(),
+	}
+	if routingType == ntgRoutingStatic {
+		state.Routing.NetworkCidrs, diags = types.ListValueFrom(ctx, types.StringType, cidrs)
+	}
+	if routing.Data.BgpDataResponseObj != nil {
+		state.Routing.AsNumber = types.StringValue(routing.Data.BgpDataResponseObj.AsNumber)
+	}
+	return diags
+}
+
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *networkTunnelGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Info(ctx, "Updating Network Tunnel Group")
@@ -390,34 +775,26 @@ func (r *networkTunnelGroupResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	tunnelId := plan.Id.ValueInt64()
-	var patchInners []ntg.PatchNetworkTunnelGroupRequestInner
-
-	// Check for name changes
-	if !plan.Name.Equal(state.Name) {
-		name := plan.Name.ValueString()
-		valueField := ntg.StringAsPatchNetworkTunnelGroupRequestInnerValue(&name)
-		patchInners = append(patchInners, *ntg.NewPatchNetworkTunnelGroupRequestInner("replace", "/name", valueField))
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	
-	// Check for preshared key changes
-	if !plan.PresharedKey.Equal(state.PresharedKey) {
-		key := plan.PresharedKey.ValueString()
-		keyField := ntg.StringAsPatchNetworkTunnelGroupRequestInnerValue(&key)
-		patchInners = append(patchInners, *ntg.NewPatchNetworkTunnelGroupRequestInner("replace", "/passphrase", keyField))
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// The key to write is taken from the configuration
+	var presharedKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("preshared_key"), &presharedKey)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	
-	// Check for network CIDR changes
-	if !compareStringSlicesAsSets(state.NetworkCidrs, plan.NetworkCidrs) {
-		routeList := convertNetworkCidrsToStrings(plan.NetworkCidrs)
-		route := ntg.RoutingRequest{
-			Type: "static",
-			Data: ntg.RoutingRequestData{
-				StaticDataRequestObj: &ntg.StaticDataRequestObj{NetworkCIDRs: routeList},
-			},
-		}
-		valueField := ntg.RoutingRequestAsPatchNetworkTunnelGroupRequestInnerValue(&route)
-		patchInners = append(patchInners, *ntg.NewPatchNetworkTunnelGroupRequestInner("replace", "/routing", valueField))
+
+	tunnelId := plan.Id.ValueInt64()
+	patchInners, diags := ntgPatchOperations(ctx, plan, state, presharedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Only make API call if there are changes
@@ -446,11 +823,83 @@ func (r *networkTunnelGroupResource) Update(ctx context.Context, req resource.Up
 	// Update the state with planned values
 	state.Name = plan.Name
 	state.NetworkCidrs = plan.NetworkCidrs
-	state.PresharedKey = plan.PresharedKey
+	state.Routing = plan.Routing
+	state.PresharedKeyVersion = plan.PresharedKeyVersion
+	state.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 }
 
+// ntgPatchOperations returns the JSON patch operations that move a network
+// tunnel group from state to plan. presharedKey, the configured key, is only
+// written when preshared_key_version changed.
+func ntgPatchOperations(ctx context.Context, plan, state ntgResourceModel, presharedKey types.String) ([]ntg.PatchNetworkTunnelGroupRequestInner, diag.Diagnostics) {
+	var patchInners []ntg.PatchNetworkTunnelGroupRequestInner
+	var diags diag.Diagnostics
+
+	// Check for name changes
+	if !plan.Name.Equal(state.Name) {
+		name := plan.Name.ValueString()
+		valueField := ntg.StringAsPatchNetworkTunnelGroupRequestInnerValue(&name)
+		patchInners = append(patchInners, *ntg.NewPatchNetworkTunnelGroupRequestInner("replace", "/name", valueField))
+	}
+
+	// Check for requested key rotations
+	if !plan.PresharedKeyVersion.Equal(state.PresharedKeyVersion) && !presharedKey.IsNull() {
+		key := presharedKey.ValueString()
+		keyField := ntg.StringAsPatchNetworkTunnelGroupRequestInnerValue(&key)
+		patchInners = append(patchInners, *ntg.NewPatchNetworkTunnelGroupRequestInner("replace", "/passphrase", keyField))
+	}
+
+	// Check for routing changes
+	changed, diags := ntgRoutingChanged(ctx, plan, state)
+	if changed && !diags.HasError() {
+		route, routeDiags := ntgRoutingRequest(ctx, plan)
+		diags.Append(routeDiags...)
+		valueField := ntg.RoutingRequestAsPatchNetworkTunnelGroupRequestInnerValue(&route)
+		patchInners = append(patchInners, *ntg.NewPatchNetworkTunnelGroupRequestInner("replace", "/routing", valueField))
+	}
+
+	return patchInners, diags
+}
+
+// ImportState imports an existing network tunnel group by its numeric ID or
+// name. The preshared key cannot be read back from the API, so it is left
+// unset and the tunnel group keeps its key until preshared_key_version
+// changes.
+func (r *networkTunnelGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := resolveImportID(ctx, req.ID, "network tunnel group", r.findNetworkTunnelGroupsByName)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// findNetworkTunnelGroupsByName returns the IDs of all network tunnel groups
+// named name.
+func (r *networkTunnelGroupResource) findNetworkTunnelGroupsByName(ctx context.Context, name string) ([]int64, error) {
+	var ids []int64
+	offset := int64(0)
+	for {
+		listResp, _, err := r.client.NetworkTunnelGroupsAPI.ListNetworkTunnelGroups(ctx).Offset(offset).Limit(importLookupPageLimit).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range listResp.Data {
+			if group.GetName() == name {
+				ids = append(ids, group.GetId())
+			}
+		}
+
+		offset += int64(len(listResp.Data))
+		if len(listResp.Data) < importLookupPageLimit || (listResp.Total != nil && offset >= *listResp.Total) {
+			return ids, nil
+		}
+	}
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *networkTunnelGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
@@ -461,6 +910,14 @@ func (r *networkTunnelGroupResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tunnelId := state.Id.ValueInt64()
 	tflog.Info(ctx, "Deleting network tunnel group", map[string]interface{}{"id": tunnelId})
 
@@ -5,14 +5,27 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/ntg"
 )
 
 // Test constants for network tunnel group tests
@@ -26,6 +39,7 @@ const (
 	testNTGNetworkCIDR      = "10.10.110.0/24"
 	testNTGNetworkCIDR2     = "10.10.111.0/24"
 	testNTGUpdatedCIDR      = "10.10.112.0/24"
+	testNTGASNumber         = "65010"
 )
 
 // Common test helper functions
@@ -58,7 +72,8 @@ func commonNTGStateChecks(resourceName, expectedName, identifierPrefix string) [
 		statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("region"), knownvalue.StringExact(testNTGRegion)),
 		statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("identifier_prefix"), knownvalue.StringExact(identifierPrefix)),
 		statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("device_type"), knownvalue.StringExact(testNTGDeviceType)),
-		statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("preshared_key"), knownvalue.StringExact(testNTGPresharedKey)),
+		// The key is write-only and never stored
+		statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("preshared_key"), knownvalue.Null()),
 	}
 }
 
@@ -84,6 +99,40 @@ func TestNetworkTunnelGroup_basic(t *testing.T) {
 	}, minWaitTime)
 }
 
+func TestNetworkTunnelGroup_import(t *testing.T) {
+	rateLimitedTest(t, func() {
+		testName := generateNTGTestName("import")
+		identifierPrefix := generateNTGIdentifierPrefix("import")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccNTGBasicConfig(testName, identifierPrefix),
+					Check:  commonNTGChecks(testNTGResourceName, testName),
+				},
+				{
+					// Imported static routes are written to routing rather than
+					// the deprecated network_cidrs
+					ResourceName:            testNTGResourceName,
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"network_cidrs", "routing"},
+					ImportStateCheck:        testAccCheckNTGImportedRouting(ntgRoutingStatic),
+				},
+				{
+					ResourceName:            testNTGResourceName,
+					ImportState:             true,
+					ImportStateIdFunc:       testAccImportStateIDByName(testNTGResourceName),
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"network_cidrs", "routing"},
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
 func TestNetworkTunnelGroup_multipleCIDRs(t *testing.T) {
 	rateLimitedTest(t, func() {
 		testName := generateNTGTestName("multi")
@@ -131,9 +180,14 @@ func TestNetworkTunnelGroup_update(t *testing.T) {
 					),
 				},
 				{
-					// Update the resource name
+					// Update the resource name in place
 					Config: testAccNTGBasicConfig(updatedTestName, identifierPrefix),
-					Check:  commonNTGChecks(testNTGResourceName, updatedTestName),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(testNTGResourceName, plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: commonNTGChecks(testNTGResourceName, updatedTestName),
 					ConfigStateChecks: append(
 						commonNTGStateChecks(testNTGResourceName, updatedTestName, identifierPrefix),
 						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("network_cidrs"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact(testNTGNetworkCIDR)})),
@@ -164,9 +218,14 @@ func TestNetworkTunnelGroup_updateCIDRs(t *testing.T) {
 					),
 				},
 				{
-					// Update to multiple CIDRs
+					// Update to multiple CIDRs in place
 					Config: testAccNTGMultipleCIDRsConfig(testName, identifierPrefix),
-					Check:  commonNTGChecks(testNTGResourceName, testName),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(testNTGResourceName, plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: commonNTGChecks(testNTGResourceName, testName),
 					ConfigStateChecks: append(
 						commonNTGStateChecks(testNTGResourceName, testName, identifierPrefix),
 						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("network_cidrs"),
@@ -190,6 +249,38 @@ func TestNetworkTunnelGroup_updateCIDRs(t *testing.T) {
 	}, minWaitTime)
 }
 
+// TestNetworkTunnelGroup_replaceIdentifierPrefix tests that changing an
+// immutable attribute replaces the tunnel group
+func TestNetworkTunnelGroup_replaceIdentifierPrefix(t *testing.T) {
+	rateLimitedTest(t, func() {
+		testName := generateNTGTestName("replace")
+		identifierPrefix := generateNTGIdentifierPrefix("replace")
+		updatedIdentifierPrefix := identifierPrefix + "x"
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccNTGBasicConfig(testName, identifierPrefix),
+					Check:  commonNTGChecks(testNTGResourceName, testName),
+				},
+				{
+					Config: testAccNTGBasicConfig(testName, updatedIdentifierPrefix),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(testNTGResourceName, plancheck.ResourceActionDestroyBeforeCreate),
+							plancheck.ExpectUnknownValue(testNTGResourceName, tfjsonpath.New("hubs")),
+						},
+					},
+					Check:             commonNTGChecks(testNTGResourceName, testName),
+					ConfigStateChecks: commonNTGStateChecks(testNTGResourceName, testName, updatedIdentifierPrefix),
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
 // TestNetworkTunnelGroup_updatePresharedKey tests updating preshared key
 func TestNetworkTunnelGroup_updatePresharedKey(t *testing.T) {
 	rateLimitedTest(t, func() {
@@ -211,15 +302,15 @@ func TestNetworkTunnelGroup_updatePresharedKey(t *testing.T) {
 					),
 				},
 				{
-					// Update preshared key
-					Config: testAccNTGCustomPresharedKeyConfig(testName, identifierPrefix, updatedPresharedKey),
+					// Rotate the preshared key, which is written with a new preshared_key_version
+					Config: testAccNTGCustomPresharedKeyConfig(testName, identifierPrefix, updatedPresharedKey, 1),
 					Check:  commonNTGChecks(testNTGResourceName, testName),
 					ConfigStateChecks: []statecheck.StateCheck{
 						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("name"), knownvalue.StringExact(testName)),
 						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("region"), knownvalue.StringExact(testNTGRegion)),
 						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("identifier_prefix"), knownvalue.StringExact(identifierPrefix)),
 						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("device_type"), knownvalue.StringExact(testNTGDeviceType)),
-						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("preshared_key"), knownvalue.StringExact(updatedPresharedKey)),
+						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("preshared_key"), knownvalue.Null()),
 						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("network_cidrs"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact(testNTGNetworkCIDR)})),
 					},
 				},
@@ -228,6 +319,453 @@ func TestNetworkTunnelGroup_updatePresharedKey(t *testing.T) {
 	}, minWaitTime)
 }
 
+// TestNetworkTunnelGroup_presharedKeyVersion tests that bumping
+// preshared_key_version rewrites the key in place
+func TestNetworkTunnelGroup_presharedKeyVersion(t *testing.T) {
+	rateLimitedTest(t, func() {
+		testName := generateNTGTestName("pskVersion")
+		identifierPrefix := generateNTGIdentifierPrefix("pskver")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:            testAccNTGPresharedKeyVersionConfig(testName, identifierPrefix, 1),
+					Check:             commonNTGChecks(testNTGResourceName, testName),
+					ConfigStateChecks: commonNTGStateChecks(testNTGResourceName, testName, identifierPrefix),
+				},
+				{
+					Config: testAccNTGPresharedKeyVersionConfig(testName, identifierPrefix, 2),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(testNTGResourceName, plancheck.ResourceActionUpdate),
+						},
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("preshared_key_version"), knownvalue.Int64Exact(2)),
+					},
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+func TestNetworkTunnelGroup_bgpRouting(t *testing.T) {
+	rateLimitedTest(t, func() {
+		testName := generateNTGTestName("bgp")
+		identifierPrefix := generateNTGIdentifierPrefix("bgp")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccNTGRoutingConfig(testName, identifierPrefix, fmt.Sprintf(`{ type = "bgp", as_number = "%s" }`, testNTGASNumber)),
+					Check:  commonNTGChecks(testNTGResourceName, testName),
+					ConfigStateChecks: append(
+						commonNTGStateChecks(testNTGResourceName, testName, identifierPrefix),
+						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("routing").AtMapKey("type"), knownvalue.StringExact(ntgRoutingBGP)),
+						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("routing").AtMapKey("as_number"), knownvalue.StringExact(testNTGASNumber)),
+						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("network_cidrs"), knownvalue.Null()),
+					),
+				},
+				{
+					// Switching to static routing updates the group in place
+					Config: testAccNTGRoutingConfig(testName, identifierPrefix, fmt.Sprintf(`{ type = "static", network_cidrs = ["%s"] }`, testNTGNetworkCIDR)),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(testNTGResourceName, plancheck.ResourceActionUpdate),
+						},
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("routing").AtMapKey("type"), knownvalue.StringExact(ntgRoutingStatic)),
+						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("routing").AtMapKey("network_cidrs"), knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact(testNTGNetworkCIDR)})),
+						statecheck.ExpectKnownValue(testNTGResourceName, tfjsonpath.New("routing").AtMapKey("as_number"), knownvalue.Null()),
+					},
+				},
+				{
+					ResourceName:      testNTGResourceName,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+// TestNetworkTunnelGroup_invalidRegion tests that an unknown region fails at plan time
+func TestNetworkTunnelGroup_invalidRegion(t *testing.T) {
+	rateLimitedTest(t, func() {
+		testName := generateNTGTestName("region")
+		identifierPrefix := generateNTGIdentifierPrefix("region")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      strings.Replace(testAccNTGBasicConfig(testName, identifierPrefix), testNTGRegion, "no-such-region", 1),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile(`Invalid Region`),
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+func TestNetworkTunnelGroup_invalidDeviceType(t *testing.T) {
+	rateLimitedTest(t, func() {
+		testName := generateNTGTestName("device")
+		identifierPrefix := generateNTGIdentifierPrefix("device")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      strings.Replace(testAccNTGBasicConfig(testName, identifierPrefix), `device_type       = "other"`, `device_type       = "Meraki"`, 1),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile(`value must be one of`),
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+// testNTGPlanValue returns a tunnel group object with every attribute null
+// except attributes
+func testNTGPlanValue(t *testing.T, ctx context.Context, schemaType tftypes.Object, attributes map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+	values := make(map[string]tftypes.Value, len(schemaType.AttributeTypes))
+	for name, attributeType := range schemaType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+	return tftypes.NewValue(schemaType, values)
+}
+
+func TestNTGModifyPlan_unknownRouting(t *testing.T) {
+	ctx := context.Background()
+	r := &networkTunnelGroupResource{}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	known := map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.Number, 7),
+		"name":              tftypes.NewValue(tftypes.String, "branch"),
+		"region":            tftypes.NewValue(tftypes.String, testNTGRegion),
+		"identifier_prefix": tftypes.NewValue(tftypes.String, testNTGIdentifierPrefix),
+		"preshared_key":     tftypes.NewValue(tftypes.String, testNTGPresharedKey),
+		"device_type":       tftypes.NewValue(tftypes.String, testNTGDeviceType),
+		"network_cidrs":     tftypes.NewValue(schemaType.AttributeTypes["network_cidrs"], []tftypes.Value{tftypes.NewValue(tftypes.String, testNTGNetworkCIDR)}),
+	}
+	// CIDRs and routing computed by other resources are unknown while planning
+	unknown := map[string]tftypes.Value{}
+	for name, value := range known {
+		unknown[name] = value
+	}
+	unknown["network_cidrs"] = tftypes.NewValue(schemaType.AttributeTypes["network_cidrs"], tftypes.UnknownValue)
+	unknown["routing"] = tftypes.NewValue(schemaType.AttributeTypes["routing"], tftypes.UnknownValue)
+
+	for name, state := range map[string]tftypes.Value{
+		"create": tftypes.NewValue(schemaType, nil),
+		"update": testNTGPlanValue(t, ctx, schemaType, known),
+	} {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: testNTGPlanValue(t, ctx, schemaType, unknown)}
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+				Plan:   plan,
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+			}
+			resp := fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, req, &resp)
+			if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 0 {
+				t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+		})
+	}
+
+	// The key is optional, but a tunnel group cannot be created without one
+	delete(known, "preshared_key")
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: testNTGPlanValue(t, ctx, schemaType, known)}
+	req := fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+		Plan:   plan,
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)},
+	}
+	resp := fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, req, &resp)
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != "Missing Preshared Key" {
+		t.Errorf("create without a preshared key: diagnostics %v, want Missing Preshared Key", resp.Diagnostics)
+	}
+}
+
+func TestNTGPatchOperations(t *testing.T) {
+	state := ntgResourceModel{
+		Name:                types.StringValue("branch"),
+		NetworkCidrs:        []types.String{types.StringValue(testNTGNetworkCIDR)},
+		PresharedKeyVersion: types.Int64Value(1),
+	}
+	key := types.StringValue(testNTGPresharedKey)
+	paths := func(plan ntgResourceModel) []string {
+		var result []string
+		operations, _ := ntgPatchOperations(context.Background(), plan, state, key)
+		for _, operation := range operations {
+			result = append(result, operation.Path)
+		}
+		return result
+	}
+
+	if got := paths(state); len(got) != 0 {
+		t.Errorf("unchanged plan patches %v, want nothing", got)
+	}
+
+	rotated := state
+	rotated.PresharedKeyVersion = types.Int64Value(2)
+	if got := paths(rotated); len(got) != 1 || got[0] != "/passphrase" {
+		t.Errorf("preshared_key_version change patches %v, want [/passphrase]", got)
+	}
+
+	// The key is only written when preshared_key_version changes
+	key = types.StringValue(testNTGPresharedKey + "X")
+	changed := state
+	changed.Name = types.StringValue("branch2")
+	if got := paths(changed); len(got) != 1 || got[0] != "/name" {
+		t.Errorf("name and key change patches %v, want [/name]", got)
+	}
+	changed.PresharedKeyVersion = types.Int64Value(2)
+	if got := paths(changed); len(got) != 2 || got[0] != "/name" || got[1] != "/passphrase" {
+		t.Errorf("name, key and version change patches %v, want [/name /passphrase]", got)
+	}
+
+	key = types.StringNull()
+	if got := paths(rotated); len(got) != 0 {
+		t.Errorf("version change without a key patches %v, want nothing", got)
+	}
+	key = types.StringValue(testNTGPresharedKey)
+
+	rerouted := state
+	rerouted.NetworkCidrs = []types.String{types.StringValue(testNTGUpdatedCIDR)}
+	if got := paths(rerouted); len(got) != 1 || got[0] != "/routing" {
+		t.Errorf("network_cidrs change patches %v, want [/routing]", got)
+	}
+
+	bgp := state
+	bgp.NetworkCidrs = nil
+	bgp.Routing = &ntgRoutingModel{
+		Type:         types.StringValue(ntgRoutingBGP),
+		NetworkCidrs: types.ListNull(types.StringType),
+		AsNumber:     types.StringValue(testNTGASNumber),
+	}
+	if got := paths(bgp); len(got) != 1 || got[0] != "/routing" {
+		t.Errorf("routing change patches %v, want [/routing]", got)
+	}
+}
+
+func TestNTGReplacedAttributes(t *testing.T) {
+	state := ntgPlanModel{
+		Name:             types.StringValue("branch"),
+		Region:           types.StringValue(testNTGRegion),
+		IdentifierPrefix: types.StringValue(testNTGIdentifierPrefix),
+		DeviceType:       types.StringValue(testNTGDeviceType),
+	}
+
+	renamed := state
+	renamed.Name = types.StringValue("branch2")
+	if got := ntgReplacedAttributes(renamed, state); len(got) != 0 {
+		t.Errorf("rename replaces because of %v, want in-place update", got)
+	}
+
+	moved := state
+	moved.Region = types.StringValue("us-test-3")
+	moved.DeviceType = types.StringUnknown()
+	got := ntgReplacedAttributes(moved, state)
+	if len(got) != 2 || got[0] != "region" || got[1] != "device_type" {
+		t.Errorf("replaced attributes = %v, want [region device_type]", got)
+	}
+	for _, attribute := range got {
+		if ntgImmutableAttributes[attribute] == "" {
+			t.Errorf("no replacement explanation for %s", attribute)
+		}
+	}
+}
+
+func TestValidateNTGRouting(t *testing.T) {
+	cidrs := types.ListValueMust(types.StringType, []attr.Value{types.StringValue(testNTGNetworkCIDR)})
+	noCidrs := types.ListNull(types.StringType)
+	asNumber := types.StringValue(testNTGASNumber)
+	noASNumber := types.StringNull()
+
+	cases := []struct {
+		name         string
+		routingType  types.String
+		networkCidrs types.List
+		asNumber     types.String
+		wantErrors   int
+	}{
+		{"no routing", types.StringNull(), noCidrs, noASNumber, 0},
+		{"unknown type", types.StringUnknown(), cidrs, asNumber, 0},
+		{"static", types.StringValue(ntgRoutingStatic), cidrs, noASNumber, 0},
+		{"static without cidrs", types.StringValue(ntgRoutingStatic), noCidrs, noASNumber, 1},
+		{"static with as number", types.StringValue(ntgRoutingStatic), cidrs, asNumber, 1},
+		{"bgp", types.StringValue(ntgRoutingBGP), noCidrs, asNumber, 0},
+		{"bgp without as number", types.StringValue(ntgRoutingBGP), noCidrs, noASNumber, 1},
+		{"bgp with cidrs", types.StringValue(ntgRoutingBGP), cidrs, asNumber, 1},
+		{"nat", types.StringValue(ntgRoutingNAT), noCidrs, noASNumber, 0},
+		{"nat with everything", types.StringValue(ntgRoutingNAT), cidrs, asNumber, 2},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateNTGRouting(tc.routingType, tc.networkCidrs, tc.asNumber)
+			if diags.ErrorsCount() != tc.wantErrors {
+				t.Errorf("got %d errors, want %d: %v", diags.ErrorsCount(), tc.wantErrors, diags)
+			}
+		})
+	}
+}
+
+func TestNTGRoutingRequest(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name    string
+		routing *ntgRoutingModel
+		want    string
+	}{
+		{"legacy network_cidrs", nil, `{"data":{"networkCIDRs":["10.10.110.0/24"]},"type":"static"}`},
+		{"static", &ntgRoutingModel{
+			Type:         types.StringValue(ntgRoutingStatic),
+			NetworkCidrs: types.ListValueMust(types.StringType, []attr.Value{types.StringValue(testNTGUpdatedCIDR)}),
+			AsNumber:     types.StringNull(),
+		}, `{"data":{"networkCIDRs":["10.10.112.0/24"]},"type":"static"}`},
+		{"bgp", &ntgRoutingModel{
+			Type:         types.StringValue(ntgRoutingBGP),
+			NetworkCidrs: types.ListNull(types.StringType),
+			AsNumber:     types.StringValue(testNTGASNumber),
+		}, `{"data":{"asNumber":"65010"},"type":"bgp"}`},
+		{"nat", &ntgRoutingModel{
+			Type:         types.StringValue(ntgRoutingNAT),
+			NetworkCidrs: types.ListNull(types.StringType),
+			AsNumber:     types.StringNull(),
+		}, `{"data":"","type":"nat"}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := ntgResourceModel{
+				NetworkCidrs: []types.String{types.StringValue(testNTGNetworkCIDR)},
+				Routing:      tc.routing,
+			}
+			if tc.routing != nil {
+				plan.NetworkCidrs = nil
+			}
+			routing, diags := ntgRoutingRequest(ctx, plan)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			got, err := json.Marshal(routing)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+
+	// Unknown routing types are not sent as static routing
+	_, diags := ntgRoutingRequest(ctx, ntgResourceModel{Routing: &ntgRoutingModel{
+		Type:         types.StringValue("ospf"),
+		NetworkCidrs: types.ListNull(types.StringType),
+		AsNumber:     types.StringNull(),
+	}})
+	if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != "Invalid Routing Type" {
+		t.Errorf("unknown routing type: diagnostics %v, want Invalid Routing Type", diags)
+	}
+}
+
+func TestSetNTGRoutingState(t *testing.T) {
+	ctx := context.Background()
+	decode := func(t *testing.T, body string) *ntg.RoutingResponse {
+		t.Helper()
+		var routing ntg.RoutingResponse
+		if err := json.Unmarshal([]byte(body), &routing); err != nil {
+			t.Fatalf("decoding %s: %v", body, err)
+		}
+		return &routing
+	}
+	staticRouting := `{"type":"static","data":{"networkCIDRs":["10.10.110.0/24"]}}`
+
+	// Imported groups have no prior routing, it is written to routing
+	var imported ntgResourceModel
+	if diags := setNTGRoutingState(ctx, decode(t, staticRouting), &imported); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if imported.NetworkCidrs != nil || imported.Routing == nil || imported.Routing.Type.ValueString() != ntgRoutingStatic ||
+		!imported.Routing.NetworkCidrs.Equal(types.ListValueMust(types.StringType, []attr.Value{types.StringValue(testNTGNetworkCIDR)})) {
+		t.Errorf("imported static state = %v routing %+v", imported.NetworkCidrs, imported.Routing)
+	}
+
+	// Configurations using network_cidrs keep static routes there
+	legacy := ntgResourceModel{NetworkCidrs: convertStringsToNetworkCidrs([]string{"10.10.0.0/16"})}
+	if diags := setNTGRoutingState(ctx, decode(t, staticRouting), &legacy); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if legacy.Routing != nil || len(legacy.NetworkCidrs) != 1 || legacy.NetworkCidrs[0].ValueString() != testNTGNetworkCIDR {
+		t.Errorf("legacy static state = %v routing %v", legacy.NetworkCidrs, legacy.Routing)
+	}
+
+	// Routing changed outside of Terraform moves to the routing attribute
+	if diags := setNTGRoutingState(ctx, decode(t, `{"type":"bgp","data":{"asNumber":"65010"}}`), &legacy); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if legacy.NetworkCidrs != nil || legacy.Routing == nil ||
+		legacy.Routing.Type.ValueString() != ntgRoutingBGP || legacy.Routing.AsNumber.ValueString() != testNTGASNumber ||
+		!legacy.Routing.NetworkCidrs.IsNull() {
+		t.Errorf("bgp state = %v routing %+v", legacy.NetworkCidrs, legacy.Routing)
+	}
+
+	static := ntgResourceModel{Routing: &ntgRoutingModel{}}
+	if diags := setNTGRoutingState(ctx, decode(t, staticRouting), &static); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := types.ListValueMust(types.StringType, []attr.Value{types.StringValue(testNTGNetworkCIDR)})
+	if !static.Routing.NetworkCidrs.Equal(want) || !static.Routing.AsNumber.IsNull() {
+		t.Errorf("static routing state = %+v", static.Routing)
+	}
+
+	nat := ntgResourceModel{Routing: &ntgRoutingModel{}}
+	if diags := setNTGRoutingState(ctx, decode(t, `{"type":"nat","data":""}`), &nat); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if nat.Routing.Type.ValueString() != ntgRoutingNAT || !nat.Routing.NetworkCidrs.IsNull() || !nat.Routing.AsNumber.IsNull() {
+		t.Errorf("nat routing state = %+v", nat.Routing)
+	}
+}
+
+// testAccCheckNTGImportedRouting checks that an imported tunnel group holds
+// its routing in the routing attribute and leaves network_cidrs unset
+func testAccCheckNTGImportedRouting(routingType string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 imported tunnel group, got %d", len(states))
+		}
+		attributes := states[0].Attributes
+		if got := attributes["routing.type"]; got != routingType {
+			return fmt.Errorf("routing.type = %q, want %q", got, routingType)
+		}
+		if count := attributes["network_cidrs.#"]; count != "" && count != "0" {
+			return fmt.Errorf("network_cidrs holds %s CIDRs after import, want none", count)
+		}
+		return nil
+	}
+}
+
 // Configuration generators for different test scenarios
 
 // testAccNTGBasicConfig returns a basic NTG configuration with single CIDR
@@ -243,6 +781,19 @@ resource "ciscosecureaccess_network_tunnel_group" "test_resource" {
 }`, name, testNTGNetworkCIDR, testNTGRegion, identifierPrefix, testNTGPresharedKey, testNTGDeviceType)
 }
 
+// testAccNTGRoutingConfig returns an NTG configuration with the given routing block
+func testAccNTGRoutingConfig(name, identifierPrefix, routing string) string {
+	return fmt.Sprintf(`
+resource "ciscosecureaccess_network_tunnel_group" "test_resource" {
+    name              = "%s"
+    routing           = %s
+    region            = "%s"
+    identifier_prefix = "%s"
+    preshared_key     = "%s"
+    device_type       = "%s"
+}`, name, routing, testNTGRegion, identifierPrefix, testNTGPresharedKey, testNTGDeviceType)
+}
+
 // testAccNTGMultipleCIDRsConfig returns an NTG configuration with multiple CIDRs
 func testAccNTGMultipleCIDRsConfig(name, identifierPrefix string) string {
 	return fmt.Sprintf(`
@@ -269,15 +820,32 @@ resource "ciscosecureaccess_network_tunnel_group" "test_resource" {
 }`, name, testNTGUpdatedCIDR, testNTGRegion, identifierPrefix, testNTGPresharedKey, testNTGDeviceType)
 }
 
-// testAccNTGCustomPresharedKeyConfig returns an NTG configuration with custom preshared key
-func testAccNTGCustomPresharedKeyConfig(name, identifierPrefix, presharedKey string) string {
+// testAccNTGCustomPresharedKeyConfig returns an NTG configuration with custom
+// preshared key and preshared_key_version
+func testAccNTGCustomPresharedKeyConfig(name, identifierPrefix, presharedKey string, version int) string {
 	return fmt.Sprintf(`
 resource "ciscosecureaccess_network_tunnel_group" "test_resource" {
-    name              = "%s"
-    network_cidrs     = ["%s"]
-    region            = "%s"
-    identifier_prefix = "%s"
-    preshared_key     = "%s"
-    device_type       = "%s"
-}`, name, testNTGNetworkCIDR, testNTGRegion, identifierPrefix, presharedKey, testNTGDeviceType)
+    name                  = "%s"
+    network_cidrs         = ["%s"]
+    region                = "%s"
+    identifier_prefix     = "%s"
+    preshared_key         = "%s"
+    preshared_key_version = %d
+    device_type           = "%s"
+}`, name, testNTGNetworkCIDR, testNTGRegion, identifierPrefix, presharedKey, version, testNTGDeviceType)
+}
+
+// testAccNTGPresharedKeyVersionConfig returns a basic NTG configuration with
+// preshared_key_version set
+func testAccNTGPresharedKeyVersionConfig(name, identifierPrefix string, version int) string {
+	return fmt.Sprintf(`
+resource "ciscosecureaccess_network_tunnel_group" "test_resource" {
+    name                  = "%s"
+    network_cidrs         = ["%s"]
+    region                = "%s"
+    identifier_prefix     = "%s"
+    preshared_key         = "%s"
+    preshared_key_version = %d
+    device_type           = "%s"
+}`, name, testNTGNetworkCIDR, testNTGRegion, identifierPrefix, testNTGPresharedKey, version, testNTGDeviceType)
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
 	"github.com/CiscoDevNet/go-ciscosecureaccess/privateapps"
 	"github.com/avast/retry-go/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -36,6 +37,7 @@ var (
 	_ resource.Resource                   = &privateResourceResource{}
 	_ resource.ResourceWithConfigure      = &privateResourceResource{}
 	_ resource.ResourceWithValidateConfig = &privateResourceResource{}
+	_ resource.ResourceWithImportState    = &privateResourceResource{}
 )
 
 // Constants for private resource management
@@ -52,13 +54,10 @@ const (
 	browserProtocolRDPTCP = "rdp-tcp"
 
 	// HTTP status codes
-	privateResourceHTTPNotFound    = 404
-	privateResourceHTTPConflict    = 409
-	privateResourceHTTPTooManyReqs = 429
+	privateResourceHTTPNotFound = 404
 
-	// Retry configuration
-	retryMaxAttempts = 3
-	retryBaseDelay   = time.Second * 2
+	// Initial delay between retries, which run until the operation timeout
+	retryBaseDelay = time.Second * 2
 
 	// Resource names
 	privateResourceName     = "ciscosecureaccess_private_resource"
@@ -77,18 +76,19 @@ type privateResourceResource struct {
 
 // privateResourceResourceModel maps the data schema data.
 type privateResourceResourceModel struct {
-	ID                            types.String `tfsdk:"id"`
-	Name                          types.String `tfsdk:"name"`
-	AccessTypes                   types.Set    `tfsdk:"access_types"`
-	Addresses                     types.Set    `tfsdk:"addresses"`
-	Description                   types.String `tfsdk:"description"`
-	ClientReachableAddresses      types.Set    `tfsdk:"client_reachable_addresses"`
-	CertificateID                 types.Int64  `tfsdk:"certificate_id"`
-	BrowserProtocol               types.String `tfsdk:"browser_protocol"`
-	BrowserExternalFQDNPrefix     types.String `tfsdk:"browser_external_fqdn_prefix"`
-	BrowserSNI                    types.String `tfsdk:"browser_sni"`
-	BrowserSSLVerificationEnabled types.Bool   `tfsdk:"browser_ssl_verification_enabled"`
-	BrowserExternalFQDN           types.String `tfsdk:"browser_external_fqdn"`
+	ID                            types.String   `tfsdk:"id"`
+	Name                          types.String   `tfsdk:"name"`
+	AccessTypes                   types.Set      `tfsdk:"access_types"`
+	Addresses                     types.Set      `tfsdk:"addresses"`
+	Description                   types.String   `tfsdk:"description"`
+	ClientReachableAddresses      types.Set      `tfsdk:"client_reachable_addresses"`
+	CertificateID                 types.Int64    `tfsdk:"certificate_id"`
+	BrowserProtocol               types.String   `tfsdk:"browser_protocol"`
+	BrowserExternalFQDNPrefix     types.String   `tfsdk:"browser_external_fqdn_prefix"`
+	BrowserSNI                    types.String   `tfsdk:"browser_sni"`
+	BrowserSSLVerificationEnabled types.Bool     `tfsdk:"browser_ssl_verification_enabled"`
+	BrowserExternalFQDN           types.String   `tfsdk:"browser_external_fqdn"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // ValidAccessTypes returns the valid access types for private resources
@@ -258,7 +258,7 @@ func (r *privateResourceResource) Configure(ctx context.Context, req resource.Co
 }
 
 // Schema defines the schema for the resource.
-func (r *privateResourceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *privateResourceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -337,6 +337,9 @@ func (r *privateResourceResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -720,6 +723,14 @@ func (r *privateResourceResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, "Creating private resource", map[string]interface{}{
 		"resource_name": plan.Name.ValueString(),
 	})
@@ -732,7 +743,7 @@ func (r *privateResourceResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	// Create the resource with retry logic
-	createResp, err := r.createPrivateResourceWithRetry(ctx, resourceDefinition)
+	createResp, err := r.createPrivateResource(ctx, resourceDefinition)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating private resource",
@@ -759,59 +770,31 @@ func (r *privateResourceResource) Create(ctx context.Context, req resource.Creat
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-// createPrivateResourceWithRetry creates a private resource with retry logic for handling conflicts
-func (r *privateResourceResource) createPrivateResourceWithRetry(ctx context.Context, resourceDefinition *privateapps.PrivateResourceRequest) (*privateapps.PrivateResourceResponse, error) {
-	var createResp *privateapps.PrivateResourceResponse
-	var err error
-
-	err = retry.Do(
-		func() error {
-			var httpRes *http.Response
-			createResp, httpRes, err = r.client.PrivateResourcesAPI.AddPrivateResource(ctx).PrivateResourceRequest(*resourceDefinition).Execute()
-
-			if err != nil {
-				if httpRes != nil {
-					bodyBytes, _ := io.ReadAll(httpRes.Body)
-					statusCode := httpRes.StatusCode
-
-					tflog.Debug(ctx, "Private resource creation attempt failed", map[string]interface{}{
-						"status_code":   statusCode,
-						"response_body": string(bodyBytes),
-						"error":         err.Error(),
-					})
-
-					if statusCode == privateResourceHTTPConflict || statusCode == privateResourceHTTPTooManyReqs {
-						// Retryable errors
-						return fmt.Errorf("retryable error (status %d): %v - %s", statusCode, err, string(bodyBytes))
-					} else {
-						// Non-retryable errors
-						tflog.Error(ctx, "Non-retryable error creating private resource", map[string]interface{}{
-							"status_code":   statusCode,
-							"response_body": string(bodyBytes),
-							"error":         err.Error(),
-						})
-						return retry.Unrecoverable(fmt.Errorf("status %d: %v - %s", statusCode, err, string(bodyBytes)))
-					}
-				} else {
-					// HTTP response is nil
-					tflog.Error(ctx, "Error creating private resource with nil HTTP response", map[string]interface{}{
-						"error": err.Error(),
-					})
-					return retry.Unrecoverable(fmt.Errorf("HTTP response is nil: %v", err))
-				}
-			}
-
-			tflog.Debug(ctx, "Private resource creation successful", map[string]interface{}{
-				"resource_id": createResp.GetResourceId(),
+// createPrivateResource creates a private resource. Conflicts and rate
+// limiting are retried by the HTTP client.
+func (r *privateResourceResource) createPrivateResource(ctx context.Context, resourceDefinition *privateapps.PrivateResourceRequest) (*privateapps.PrivateResourceResponse, error) {
+	createResp, httpRes, err := r.client.PrivateResourcesAPI.AddPrivateResource(ctx).PrivateResourceRequest(*resourceDefinition).Execute()
+	if err != nil {
+		if httpRes == nil {
+			tflog.Error(ctx, "Error creating private resource with nil HTTP response", map[string]interface{}{
+				"error": err.Error(),
 			})
-			return nil
-		},
-		retry.Attempts(retryMaxAttempts),
-		retry.Delay(retryBaseDelay),
-		retry.Context(ctx),
-	)
+			return nil, fmt.Errorf("HTTP response is nil: %v", err)
+		}
 
-	return createResp, err
+		bodyBytes, _ := io.ReadAll(httpRes.Body)
+		tflog.Error(ctx, "Error creating private resource", map[string]interface{}{
+			"status_code":   httpRes.StatusCode,
+			"response_body": string(bodyBytes),
+			"error":         err.Error(),
+		})
+		return nil, fmt.Errorf("status %d: %v - %s", httpRes.StatusCode, err, string(bodyBytes))
+	}
+
+	tflog.Debug(ctx, "Private resource creation successful", map[string]interface{}{
+		"resource_id": createResp.GetResourceId(),
+	})
+	return createResp, nil
 }
 
 func (r *privateResourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -823,6 +806,14 @@ func (r *privateResourceResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	policyId, idErr := strconv.Atoi(state.ID.ValueString())
 	if idErr != nil {
 		resp.Diagnostics.AddError(
@@ -875,7 +866,7 @@ func (r *privateResourceResource) applyPrivateResourceResponseToState(ctx contex
 	if readResp.Name != nil {
 		state.Name = types.StringValue(*readResp.Name)
 	}
-	if readResp.Description != nil {
+	if readResp.Description != nil && (*readResp.Description != "" || !state.Description.IsNull()) {
 		state.Description = types.StringValue(*readResp.Description)
 	} else {
 		state.Description = types.StringNull()
@@ -898,6 +889,11 @@ func (r *privateResourceResource) applyPrivateResourceResponseToState(ctx contex
 		return diags
 	}
 
+	if len(addressUpdates) == 0 {
+		state.Addresses = types.SetNull(types.ObjectType{AttrTypes: addressTypesModel{}.AttrTypes()})
+		return diags
+	}
+
 	var respDiags diag.Diagnostics
 	state.Addresses, respDiags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: addressTypesModel{}.AttrTypes()}, addressUpdates)
 	diags.Append(respDiags...)
@@ -923,9 +919,7 @@ func (r *privateResourceResource) readPrivateResourceIntoState(ctx context.Conte
 
 			return nil
 		},
-		retry.Attempts(retryMaxAttempts),
-		retry.Delay(retryBaseDelay),
-		retry.Context(ctx),
+		retryUntilDeadline(ctx, retryBaseDelay)...,
 	)
 	if err != nil {
 		diags.AddError(
@@ -957,11 +951,21 @@ func (r *privateResourceResource) processReadAddresses(ctx context.Context, apiA
 			}
 		}
 
+		// Empty lists are returned for omitted nested attributes, which
+		// must stay null to match the configuration
 		var addressUpdate addressTypesModel
-		addressUpdate.Addresses, _ = types.SetValueFrom(ctx, types.StringType, resourceAddress.DestinationAddr)
-		addressUpdate.TrafficSelector, diags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: trafficSelectorModel{}.AttrTypes()}, protocolPortsInner)
-		if diags.HasError() {
-			return nil, diags
+		if len(resourceAddress.DestinationAddr) > 0 {
+			addressUpdate.Addresses, _ = types.SetValueFrom(ctx, types.StringType, resourceAddress.DestinationAddr)
+		} else {
+			addressUpdate.Addresses = types.SetNull(types.StringType)
+		}
+		if len(protocolPortsInner) > 0 {
+			addressUpdate.TrafficSelector, diags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: trafficSelectorModel{}.AttrTypes()}, protocolPortsInner)
+			if diags.HasError() {
+				return nil, diags
+			}
+		} else {
+			addressUpdate.TrafficSelector = types.SetNull(types.ObjectType{AttrTypes: trafficSelectorModel{}.AttrTypes()})
 		}
 
 		tflog.Debug(ctx, "Processed address configuration", map[string]interface{}{
@@ -982,6 +986,17 @@ func (r *privateResourceResource) processReadAccessTypes(ctx context.Context, ap
 		"access_types": string(respString),
 	})
 
+	// Access types are only read back on import, before any are configured
+	if state.AccessTypes.IsNull() {
+		accessTypes := accessTypesFromResponse(apiAccessTypes)
+		var accessTypesDiags diag.Diagnostics
+		state.AccessTypes, accessTypesDiags = types.SetValueFrom(ctx, types.StringType, accessTypes)
+		if accessTypesDiags.HasError() {
+			diags.Append(accessTypesDiags...)
+			return diags
+		}
+	}
+
 	var clientTypeMissing bool = true
 	var browserTypeMissing bool = true
 
@@ -1027,6 +1042,11 @@ func (r *privateResourceResource) processReadAccessTypes(ctx context.Context, ap
 			}
 			if access.BrowserBasedAccessResponse.ExternalFQDN != nil {
 				state.BrowserExternalFQDN = types.StringValue(*access.BrowserBasedAccessResponse.ExternalFQDN)
+				if state.BrowserExternalFQDNPrefix.IsNull() {
+					if prefix := externalFQDNPrefix(*access.BrowserBasedAccessResponse.ExternalFQDN); prefix != "" {
+						state.BrowserExternalFQDNPrefix = types.StringValue(prefix)
+					}
+				}
 			} else {
 				state.BrowserExternalFQDN = types.StringNull()
 			}
@@ -1067,6 +1087,70 @@ func (r *privateResourceResource) processReadAccessTypes(ctx context.Context, ap
 	return diags
 }
 
+// accessTypesFromResponse returns the access_types values matching the access
+// types of an API response. Network access may be reported as branch access.
+func accessTypesFromResponse(apiAccessTypes []privateapps.AccessTypesInner) []string {
+	accessTypes := make([]string, 0, len(apiAccessTypes))
+	for _, access := range apiAccessTypes {
+		switch {
+		case access.ClientBasedAccess != nil:
+			accessTypes = append(accessTypes, accessTypeClient)
+		case access.BrowserBasedAccessResponse != nil:
+			accessTypes = append(accessTypes, accessTypeBrowser)
+		case access.NetworkBasedAccess != nil, access.BranchAccess != nil:
+			accessTypes = append(accessTypes, accessTypeNetwork)
+		}
+	}
+	return accessTypes
+}
+
+// externalFQDNPrefix recovers the configured browser_external_fqdn_prefix from
+// the generated external FQDN, which has the form <prefix>-<org ID>.<domain>.
+func externalFQDNPrefix(externalFQDN string) string {
+	label, _, _ := strings.Cut(externalFQDN, ".")
+	i := strings.LastIndex(label, "-")
+	if i <= 0 {
+		return ""
+	}
+	if _, err := strconv.ParseInt(label[i+1:], 10, 64); err != nil {
+		return ""
+	}
+	return label[:i]
+}
+
+// ImportState imports an existing private resource by its numeric ID or name.
+func (r *privateResourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := resolveImportID(ctx, req.ID, "private resource", r.findPrivateResourcesByName)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatInt(id, 10))...)
+}
+
+// findPrivateResourcesByName returns the IDs of all private resources named name.
+func (r *privateResourceResource) findPrivateResourcesByName(ctx context.Context, name string) ([]int64, error) {
+	var ids []int64
+	offset := int64(0)
+	for {
+		listResp, _, err := r.client.PrivateResourcesAPI.ListPrivateResources(ctx).Offset(offset).Limit(importLookupPageLimit).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, privateResource := range listResp.Items {
+			if privateResource.GetName() == name {
+				ids = append(ids, privateResource.GetResourceId())
+			}
+		}
+
+		offset += int64(len(listResp.Items))
+		if len(listResp.Items) < importLookupPageLimit || (listResp.Total != nil && offset >= *listResp.Total) {
+			return ids, nil
+		}
+	}
+}
+
 func (r *privateResourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan and state
 	var plan, state privateResourceResourceModel
@@ -1078,6 +1162,14 @@ func (r *privateResourceResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, "Updating private resource", map[string]interface{}{
 		"resource_id":   plan.ID.ValueString(),
 		"resource_name": plan.Name.ValueString(),
@@ -1168,6 +1260,14 @@ func (r *privateResourceResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	id, idErr := strconv.Atoi(state.ID.ValueString())
 	if idErr != nil {
 		resp.Diagnostics.AddError(
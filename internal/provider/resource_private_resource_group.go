@@ -0,0 +1,348 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/CiscoDevNet/go-ciscosecureaccess/privateapps"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &privateResourceGroupResource{}
+	_ resource.ResourceWithConfigure   = &privateResourceGroupResource{}
+	_ resource.ResourceWithImportState = &privateResourceGroupResource{}
+)
+
+// privateResourceGroupNamePattern matches the names the private apps API
+// accepts for private resource groups (schema privateResourceGroupName)
+var privateResourceGroupNamePattern = regexp.MustCompile(`^[a-zA-Z0-9- ]+$`)
+
+// NewPrivateResourceGroupResource is a helper function to simplify the provider implementation.
+func NewPrivateResourceGroupResource() resource.Resource {
+	return &privateResourceGroupResource{}
+}
+
+// privateResourceGroupResource is the resource implementation.
+type privateResourceGroupResource struct {
+	client privateapps.APIClient
+}
+
+// privateResourceGroupResourceModel maps the resource schema data.
+type privateResourceGroupResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	ResourceIDs types.Set      `tfsdk:"resource_ids"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *privateResourceGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_resource_group"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *privateResourceGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	factory, ok := req.ProviderData.(*client.SSEClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data Type",
+			fmt.Sprintf("expected *client.SSEClientFactory, got %T", req.ProviderData))
+		return
+	}
+	r.client = *factory.GetPrivateAppsClient(ctx)
+}
+
+// Schema defines the schema for the resource.
+func (r *privateResourceGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Group of private resources. Access policies that target the group through private_resource_group_ids " +
+			"apply to every member, so resources can be added to or removed from the group without changing the policies.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique ID of private resource group",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of private resource group, up to 100 letters, digits, spaces and hyphens",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+					stringvalidator.RegexMatches(privateResourceGroupNamePattern, "must only contain letters, digits, spaces and hyphens"),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of private resource group",
+				Optional:    true,
+			},
+			"resource_ids": schema.SetAttribute{
+				Description: "Secure Access IDs of the private resources in the group",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Create creates the private resource group and sets the initial Terraform state.
+func (r *privateResourceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan privateResourceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	request, diags := buildPrivateResourceGroupRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating private resource group", map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	})
+	group, _, err := r.client.ResourceGroupsAPI.AddPrivateResourceGroup(ctx).PrivateResourceGroupRequest(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating private resource group",
+			fmt.Sprintf("Failed to create private resource group %s: %v", plan.Name.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateResourceGroupState(ctx, group, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *privateResourceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state privateResourceGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID",
+			fmt.Sprintf("Could not parse private resource group ID %q: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	group, httpRes, err := r.client.ResourceGroupsAPI.GetPrivateResourceGroup(ctx, id).Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == privateResourceHTTPNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading private resource group",
+			fmt.Sprintf("Cannot read private resource group ID %d: %v", id, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateResourceGroupState(ctx, group, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the private resource group and sets the updated Terraform state on success.
+func (r *privateResourceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state privateResourceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID",
+			fmt.Sprintf("Could not parse private resource group ID %q: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	request, diags := buildPrivateResourceGroupRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating private resource group", map[string]interface{}{
+		"id":   id,
+		"name": plan.Name.ValueString(),
+	})
+	group, _, err := r.client.ResourceGroupsAPI.PutPrivateResourceGroup(ctx, id).PrivateResourceGroupRequest(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating private resource group",
+			fmt.Sprintf("Failed to update private resource group %d: %v", id, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateResourceGroupState(ctx, group, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the private resource group and removes the Terraform state on success.
+func (r *privateResourceGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state privateResourceGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID",
+			fmt.Sprintf("Could not parse private resource group ID %q: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleting private resource group", map[string]interface{}{
+		"id": id,
+	})
+	_, httpRes, err := r.client.ResourceGroupsAPI.DeletePrivateResourceGroup(ctx, id).Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == privateResourceHTTPNotFound {
+			tflog.Debug(ctx, "Private resource group not found, already deleted")
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting private resource group",
+			fmt.Sprintf("Could not delete private resource group ID %d: %v", id, err),
+		)
+	}
+}
+
+// ImportState imports an existing private resource group by its numeric ID or name.
+func (r *privateResourceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := resolveImportID(ctx, req.ID, "private resource group", r.findPrivateResourceGroupsByName)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatInt(id, 10))...)
+}
+
+// findPrivateResourceGroupsByName returns the IDs of all private resource groups named name.
+func (r *privateResourceGroupResource) findPrivateResourceGroupsByName(ctx context.Context, name string) ([]int64, error) {
+	var ids []int64
+	offset := int64(0)
+	for {
+		listResp, _, err := r.client.ResourceGroupsAPI.ListResourceGroups(ctx).Offset(offset).Limit(importLookupPageLimit).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range listResp.Items {
+			if group.GetName() == name {
+				ids = append(ids, group.GetResourceGroupId())
+			}
+		}
+
+		offset += int64(len(listResp.Items))
+		if len(listResp.Items) < importLookupPageLimit || (listResp.Total != nil && offset >= *listResp.Total) {
+			return ids, nil
+		}
+	}
+}
+
+// buildPrivateResourceGroupRequest converts the plan into an API request. The
+// API requires the member list, so a group without members sends an empty one.
+func buildPrivateResourceGroupRequest(ctx context.Context, plan privateResourceGroupResourceModel) (*privateapps.PrivateResourceGroupRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	resourceIDs := []int64{}
+	if !plan.ResourceIDs.IsNull() {
+		diags = plan.ResourceIDs.ElementsAs(ctx, &resourceIDs, true)
+	}
+
+	request := privateapps.NewPrivateResourceGroupRequest(plan.Name.ValueString(), resourceIDs)
+	if !plan.Description.IsNull() {
+		request.SetDescription(plan.Description.ValueString())
+	}
+	return request, diags
+}
+
+// setPrivateResourceGroupState copies an API response into the model. Empty
+// descriptions and member lists stay null when they are not configured.
+func setPrivateResourceGroupState(ctx context.Context, group *privateapps.PrivateResourceGroupResponse, state *privateResourceGroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(strconv.FormatInt(group.GetResourceGroupId(), 10))
+	state.Name = types.StringValue(group.GetName())
+	if group.GetDescription() != "" || !state.Description.IsNull() {
+		state.Description = types.StringValue(group.GetDescription())
+	}
+	if len(group.GetResourceIds()) > 0 || !state.ResourceIDs.IsNull() {
+		state.ResourceIDs, diags = types.SetValueFrom(ctx, types.Int64Type, group.GetResourceIds())
+	}
+	return diags
+}
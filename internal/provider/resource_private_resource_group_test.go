@@ -0,0 +1,150 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/privateapps"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+const testPrivateResourceGroupResourceName = "ciscosecureaccess_private_resource_group.test"
+
+func TestPrivateResourceGroupResource_basic(t *testing.T) {
+	rateLimitedTest(t, func() {
+		name := generateConnectorGroupTestName("group")
+		member := generateTestResourceName()
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			CheckDestroy:             testAccCheckPrivateResourceGroupDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccPrivateResourceGroupConfig(name, member, true),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(testPrivateResourceGroupResourceName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+						statecheck.ExpectKnownValue(testPrivateResourceGroupResourceName, tfjsonpath.New("resource_ids"), knownvalue.SetSizeExact(1)),
+					},
+				},
+				{
+					ResourceName:      testPrivateResourceGroupResourceName,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					Config: testAccPrivateResourceGroupConfig(name, member, false),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(testPrivateResourceGroupResourceName, plancheck.ResourceActionUpdate),
+						},
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(testPrivateResourceGroupResourceName, tfjsonpath.New("resource_ids"), knownvalue.SetSizeExact(0)),
+					},
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+func TestBuildPrivateResourceGroupRequest(t *testing.T) {
+	ctx := context.Background()
+
+	request, diags := buildPrivateResourceGroupRequest(ctx, privateResourceGroupResourceModel{
+		Name:        types.StringValue("Finance Apps"),
+		Description: types.StringNull(),
+		ResourceIDs: types.SetNull(types.Int64Type),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	// The API requires the member list even for an empty group
+	if request.ResourceIds == nil || len(request.ResourceIds) != 0 || request.Description != nil {
+		t.Errorf("unexpected request: %+v", request)
+	}
+
+	request, _ = buildPrivateResourceGroupRequest(ctx, privateResourceGroupResourceModel{
+		Name:        types.StringValue("Finance Apps"),
+		Description: types.StringValue("Owned by finance"),
+		ResourceIDs: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(7)}),
+	})
+	if request.GetDescription() != "Owned by finance" || len(request.ResourceIds) != 1 || request.ResourceIds[0] != 7 {
+		t.Errorf("unexpected request: %+v", request)
+	}
+}
+
+func TestSetPrivateResourceGroupState(t *testing.T) {
+	ctx := context.Background()
+	group := privateapps.NewPrivateResourceGroupResponse()
+	group.SetResourceGroupId(12)
+	group.SetName("Finance Apps")
+	group.SetDescription("")
+
+	state := privateResourceGroupResourceModel{
+		Description: types.StringNull(),
+		ResourceIDs: types.SetNull(types.Int64Type),
+	}
+	if diags := setPrivateResourceGroupState(ctx, group, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if state.ID.ValueString() != "12" || !state.Description.IsNull() || !state.ResourceIDs.IsNull() {
+		t.Errorf("unexpected state: %+v", state)
+	}
+
+	group.SetResourceIds([]int64{3, 4})
+	setPrivateResourceGroupState(ctx, group, &state)
+	if len(state.ResourceIDs.Elements()) != 2 {
+		t.Errorf("unexpected resource_ids: %s", state.ResourceIDs)
+	}
+}
+
+func testAccCheckPrivateResourceGroupDestroy(s *terraform.State) error {
+	ctx := context.Background()
+	c := testAccClientFactory().GetPrivateAppsClient(ctx)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ciscosecureaccess_private_resource_group" {
+			continue
+		}
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			continue
+		}
+		_, httpRes, _ := c.ResourceGroupsAPI.GetPrivateResourceGroup(ctx, id).Execute()
+		if httpRes == nil || httpRes.StatusCode != 404 {
+			return fmt.Errorf("private resource group %d still exists after destroy", id)
+		}
+	}
+	return nil
+}
+
+func testAccPrivateResourceGroupConfig(name, member string, withMember bool) string {
+	resourceIDs := "[]"
+	if withMember {
+		resourceIDs = "[ciscosecureaccess_private_resource.test_resource.id]"
+	}
+
+	return testAccPrivateResourceConfig(member, testAccessTypeNetwork) + fmt.Sprintf(`
+
+resource "ciscosecureaccess_private_resource_group" "test" {
+  name         = %q
+  description  = "Terraform acceptance test group"
+  resource_ids = %s
+}
+`, name, resourceIDs)
+}
@@ -8,13 +8,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 	"testing"
 
-	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
 	"github.com/CiscoDevNet/go-ciscosecureaccess/privateapps"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -405,6 +405,17 @@ func TestPrivateResourceResource_accessTypeCombinationsCRUD(t *testing.T) {
 							Config:   testAccPrivateResourceCombinationConfig(rName, accessTypes, browserPrefix, testPrivateResourceUpdatedDesc),
 							PlanOnly: true,
 						},
+						{
+							ResourceName:      testPrivateResourceName,
+							ImportState:       true,
+							ImportStateVerify: true,
+						},
+						{
+							ResourceName:      testPrivateResourceName,
+							ImportState:       true,
+							ImportStateIdFunc: testAccImportStateIDByName(testPrivateResourceName),
+							ImportStateVerify: true,
+						},
 					},
 				})
 			}, minWaitTime)
@@ -412,6 +423,35 @@ func TestPrivateResourceResource_accessTypeCombinationsCRUD(t *testing.T) {
 	}
 }
 
+func TestAccessTypesFromResponse(t *testing.T) {
+	apiAccessTypes := []privateapps.AccessTypesInner{
+		{ClientBasedAccess: &privateapps.ClientBasedAccess{Type: testAccessTypeClient}},
+		{BranchAccess: &privateapps.BranchAccess{}},
+		{BrowserBasedAccessResponse: &privateapps.BrowserBasedAccessResponse{Type: testAccessTypeBrowser}},
+	}
+
+	got := accessTypesFromResponse(apiAccessTypes)
+	want := []string{testAccessTypeClient, testAccessTypeNetwork, testAccessTypeBrowser}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("accessTypesFromResponse() = %v, want %v", got, want)
+	}
+}
+
+func TestExternalFQDNPrefix(t *testing.T) {
+	tests := map[string]string{
+		"tf-browser-jira-8000001.ztna.sse.cisco.io": "tf-browser-jira",
+		"jira-2390150.ztna.ciscoplus.com":           "jira",
+		"jira.ztna.ciscoplus.com":                   "",
+		"jira-west.ztna.ciscoplus.com":              "",
+	}
+
+	for externalFQDN, want := range tests {
+		if got := externalFQDNPrefix(externalFQDN); got != want {
+			t.Errorf("externalFQDNPrefix(%q) = %q, want %q", externalFQDN, got, want)
+		}
+	}
+}
+
 // generateTestResourceName creates a unique test resource name
 func generateTestResourceName() string {
 	return fmt.Sprintf("%s%s", testPrivateResourceNamePrefix, acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -478,6 +518,12 @@ func testPrivateResourceBrowserPlanWithBrowserProtocolAndSelector(t *testing.T,
 		BrowserSNI:                    types.StringValue(testPrivateResourceBrowserSNI),
 		BrowserSSLVerificationEnabled: types.BoolNull(),
 		BrowserExternalFQDN:           types.StringNull(),
+		Timeouts: timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		})},
 	}
 
 	if browserProtocol != "" {
@@ -664,10 +710,7 @@ resource "ciscosecureaccess_private_resource" "test_resource" {
 
 func testAccCheckPrivateResourceDestroy(s *terraform.State) error {
 	ctx := context.Background()
-	factory := &client.SSEClientFactory{
-		KeyId:     os.Getenv("CISCOSECUREACCESS_KEY_ID"),
-		KeySecret: os.Getenv("CISCOSECUREACCESS_KEY_SECRET"),
-	}
+	factory := testAccClientFactory()
 	c := factory.GetPrivateAppsClient(ctx)
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ciscosecureaccess_private_resource" {
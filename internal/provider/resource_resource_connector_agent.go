@@ -15,6 +15,7 @@ import (
 	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
 	"github.com/CiscoDevNet/go-ciscosecureaccess/resconn"
 	"github.com/avast/retry-go/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -41,9 +42,8 @@ const (
 	connectorHTTPNotFound    = 404
 	connectorHTTPTooManyReqs = 429
 
-	// Retry configuration
-	connectorRetryMaxAttempts = 6
-	connectorRetryBaseDelay   = time.Second * 10
+	// Initial delay between retries, which run until the operation timeout
+	connectorRetryBaseDelay = time.Second * 10
 
 	// JSON patch operations
 	connectorPatchOpReplace     = "replace"
@@ -61,12 +61,14 @@ type resourceConnectorAgentResource struct {
 }
 
 type resourceConnectorAgentResourceModel struct {
-	ID         types.Int64  `tfsdk:"id"`
-	InstanceID types.String `tfsdk:"instance_id"`
-	Hostname   types.String `tfsdk:"hostname"`
-	Status     types.String `tfsdk:"status"`
-	Confirmed  types.Bool   `tfsdk:"confirmed"`
-	Enabled    types.Bool   `tfsdk:"enabled"`
+	ID            types.Int64    `tfsdk:"id"`
+	InstanceID    types.String   `tfsdk:"instance_id"`
+	Hostname      types.String   `tfsdk:"hostname"`
+	Status        types.String   `tfsdk:"status"`
+	Confirmed     types.Bool     `tfsdk:"confirmed"`
+	Enabled       types.Bool     `tfsdk:"enabled"`
+	WaitForStatus types.String   `tfsdk:"wait_for_status"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceConnectorAgentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -133,6 +135,13 @@ func (r *resourceConnectorAgentResource) Schema(ctx context.Context, req resourc
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"wait_for_status": schema.StringAttribute{
+				Description: "Status, such as connected, the resource connector must report before it is created or updated. The wait is bounded by the create and update timeouts.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -155,6 +164,14 @@ func (r *resourceConnectorAgentResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, "Creating resource connector agent")
 
 	// Build filter for finding the agent
@@ -219,9 +236,7 @@ func (r *resourceConnectorAgentResource) findAndConfigureAgent(ctx context.Conte
 
 			return r.processConnectorResponse(ctx, agents, data, filters)
 		},
-		retry.Attempts(connectorRetryMaxAttempts),
-		retry.Delay(connectorRetryBaseDelay),
-		retry.Context(ctx),
+		retryUntilDeadline(ctx, connectorRetryBaseDelay)...,
 	)
 }
 
@@ -308,6 +323,10 @@ func (r *resourceConnectorAgentResource) processConnectorResponse(ctx context.Co
 			}
 			*data = state
 
+			if want := data.WaitForStatus.ValueString(); want != "" && state.Status.ValueString() != want {
+				return fmt.Errorf("connector agent %s reports status %q, waiting for %q", state.InstanceID.ValueString(), state.Status.ValueString(), want)
+			}
+
 			tflog.Info(ctx, "Successfully configured resource connector agent", map[string]interface{}{
 				"agent_id": state.ID.ValueInt64(),
 			})
@@ -343,6 +362,14 @@ func (r *resourceConnectorAgentResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	agentID := data.ID.ValueInt64()
 	tflog.Debug(ctx, "Reading resource connector agent", map[string]interface{}{
 		"agent_id": agentID,
@@ -388,9 +415,7 @@ func (r *resourceConnectorAgentResource) Read(ctx context.Context, req resource.
 			})
 			return nil
 		},
-		retry.Attempts(connectorRetryMaxAttempts),
-		retry.Delay(connectorRetryBaseDelay),
-		retry.Context(ctx),
+		retryUntilDeadline(ctx, connectorRetryBaseDelay)...,
 	)
 
 	if err != nil {
@@ -413,6 +438,14 @@ func (r *resourceConnectorAgentResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	agentID := state.ID.ValueInt64()
 	tflog.Info(ctx, "Updating resource connector agent", map[string]interface{}{
 		"agent_id": agentID,
@@ -426,6 +459,23 @@ func (r *resourceConnectorAgentResource) Update(ctx context.Context, req resourc
 		)
 		return
 	}
+	state.Timeouts = plan.Timeouts
+	state.WaitForStatus = plan.WaitForStatus
+
+	// Wait for the status on every update, so changing wait_for_status alone
+	// waits for the new status
+	if want := plan.WaitForStatus.ValueString(); want != "" {
+		agent, err := waitForAgentStatus(ctx, r.client, agentID, want)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("wait_for_status"),
+				"Resource Connector Agent Not Ready",
+				fmt.Sprintf("Resource connector agent %d did not report status %q before the timeout: %v", agentID, want, err),
+			)
+			return
+		}
+		state.Status = types.StringValue(agent.GetStatus())
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -435,6 +485,27 @@ func (r *resourceConnectorAgentResource) Update(ctx context.Context, req resourc
 	})
 }
 
+// waitForAgentStatus polls a connector agent until it reports status or ctx
+// is done, and returns the last read of the agent
+func waitForAgentStatus(ctx context.Context, apiClient resconn.APIClient, agentID int64, status string) (*resconn.ConnectorResponse, error) {
+	var agent *resconn.ConnectorResponse
+	err := retry.Do(
+		func() error {
+			var err error
+			agent, _, err = apiClient.ConnectorsAPI.GetConnector(ctx, agentID).Execute()
+			if err != nil {
+				return fmt.Errorf("failed to read resource connector agent: %w", err)
+			}
+			if agent.GetStatus() != status {
+				return fmt.Errorf("connector agent %s reports status %q, waiting for %q", agent.GetInstanceId(), agent.GetStatus(), status)
+			}
+			return nil
+		},
+		retryUntilDeadline(ctx, connectorRetryBaseDelay)...,
+	)
+	return agent, err
+}
+
 // Synchronize updates the resource connector agent based on plan changes.
 // It returns an error if any patch operation fails so callers can gate
 // state writes on success and avoid silent state drift.
@@ -482,6 +553,11 @@ func (r *resourceConnectorAgentResource) Synchronize(ctx context.Context, state
 
 // patchConnectorField updates a single field on the connector using PATCH operation
 func (r *resourceConnectorAgentResource) patchConnectorField(ctx context.Context, agentID int64, path string, value *bool) error {
+	return patchConnectorField(ctx, r.client, agentID, path, value)
+}
+
+// patchConnectorField updates a single field on a connector using PATCH operation
+func patchConnectorField(ctx context.Context, apiClient resconn.APIClient, agentID int64, path string, value *bool) error {
 	op := resconn.Op(connectorPatchOpReplace)
 	req := resconn.ConnectorPatchReqInner{
 		Op:    &op,
@@ -490,7 +566,7 @@ func (r *resourceConnectorAgentResource) patchConnectorField(ctx context.Context
 	}
 	reqs := []resconn.ConnectorPatchReqInner{req}
 
-	_, _, err := r.client.ConnectorsAPI.PatchConnector(ctx, agentID).ConnectorPatchReqInner(reqs).Execute()
+	_, _, err := apiClient.ConnectorsAPI.PatchConnector(ctx, agentID).ConnectorPatchReqInner(reqs).Execute()
 	return err
 }
 
@@ -504,15 +580,34 @@ func (r *resourceConnectorAgentResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	agentID := data.ID.ValueInt64()
 	tflog.Info(ctx, "Deleting resource connector agent", map[string]interface{}{
 		"agent_id": agentID,
 	})
 
-	// Delete API call logic with retry
-	err := retry.Do(
+	err := deleteConnectorAgent(ctx, r.client, agentID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting resource connector agent",
+			fmt.Sprintf("Could not delete resource connector agent %d: %v", agentID, err),
+		)
+	}
+}
+
+// deleteConnectorAgent deletes a connector, retrying while rate limited and
+// treating a connector that is already gone as deleted
+func deleteConnectorAgent(ctx context.Context, apiClient resconn.APIClient, agentID int64) error {
+	return retry.Do(
 		func() error {
-			_, httpRes, err := r.client.ConnectorsAPI.DeleteConnector(ctx, agentID).Execute()
+			_, httpRes, err := apiClient.ConnectorsAPI.DeleteConnector(ctx, agentID).Execute()
 
 			if httpRes != nil {
 				switch httpRes.StatusCode {
@@ -533,15 +628,6 @@ func (r *resourceConnectorAgentResource) Delete(ctx context.Context, req resourc
 			})
 			return nil
 		},
-		retry.Attempts(connectorRetryMaxAttempts),
-		retry.Delay(connectorRetryBaseDelay),
-		retry.Context(ctx),
+		retryUntilDeadline(ctx, connectorRetryBaseDelay)...,
 	)
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error deleting resource connector agent",
-			fmt.Sprintf("Could not delete resource connector agent %d: %v", agentID, err),
-		)
-	}
 }
@@ -7,7 +7,8 @@ package provider
 import (
 	"context"
 	"fmt"
-	"os"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -30,10 +31,7 @@ const (
 )
 
 func TestResourceConnectorAgentResource_instanceID(t *testing.T) {
-	if os.Getenv("TEST_CISCOSECUREACCESS_CONNECTOR_AGENT_INSTANCE_ID") == "" {
-		t.Skip("Skipping test for connector agent instance ID as environment variable TEST_CISCOSECUREACCESS_CONNECTOR_AGENT_INSTANCE_ID")
-	}
-	rName := os.Getenv("TEST_CISCOSECUREACCESS_CONNECTOR_AGENT_INSTANCE_ID") // Ensure the environment variable is set for instance ID tests
+	rName := testAccFixture(t, "TEST_CISCOSECUREACCESS_CONNECTOR_AGENT_INSTANCE_ID", seedConnectorAgent)
 	rateLimitedTest(t, func() {
 
 		resource.Test(t, resource.TestCase{
@@ -56,10 +54,7 @@ func TestResourceConnectorAgentResource_instanceID(t *testing.T) {
 }
 
 func TestResourceConnectorAgentResource_hostname(t *testing.T) {
-	if os.Getenv("TEST_CISCOSSE_CONNECTOR_AGENT_INSTANCE_ID") == "" {
-		t.Skip("Skipping test for connector agent instance ID as environment variable TEST_CISCOSSE_CONNECTOR_AGENT_INSTANCE_ID")
-	}
-	rName := os.Getenv("TEST_CISCOSSE_CONNECTOR_AGENT_INSTANCE_ID") // Ensure the environment variable is set for instance ID tests
+	rName := testAccFixture(t, "TEST_CISCOSSE_CONNECTOR_AGENT_INSTANCE_ID", seedConnectorAgent)
 	rateLimitedTest(t, func() {
 
 		resource.Test(t, resource.TestCase{
@@ -82,10 +77,7 @@ func TestResourceConnectorAgentResource_hostname(t *testing.T) {
 }
 
 func TestResourceConnectorAgentResource_enabled(t *testing.T) {
-	if os.Getenv("TEST_CISCOSSE_CONNECTOR_AGENT_INSTANCE_ID") == "" {
-		t.Skip("Skipping test for connector agent enabled as environment variable TEST_CISCOSSE_CONNECTOR_AGENT_INSTANCE_ID")
-	}
-	rName := os.Getenv("TEST_CISCOSSE_CONNECTOR_AGENT_INSTANCE_ID") // Ensure the environment variable is set for instance ID tests
+	rName := testAccFixture(t, "TEST_CISCOSSE_CONNECTOR_AGENT_INSTANCE_ID", seedConnectorAgent)
 
 	rateLimitedTest(t, func() {
 		resource.Test(t, resource.TestCase{
@@ -114,6 +106,44 @@ func TestResourceConnectorAgentResource_enabled(t *testing.T) {
 	}, 30*time.Second)
 }
 
+func TestResourceConnectorAgentResource_waitForStatus(t *testing.T) {
+	rName := testAccFixture(t, "TEST_CISCOSECUREACCESS_CONNECTOR_AGENT_INSTANCE_ID", seedConnectorAgent)
+
+	rateLimitedTest(t, func() {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			CheckDestroy: testAccCheckResourceConnectorAgentDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccResourceConnectorAgentConfigWaitForStatus(rName, "connected", "1m"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(testConnectorAgentResourceName, "status", "connected"),
+						resource.TestCheckResourceAttr(testConnectorAgentResourceName, "wait_for_status", "connected"),
+					),
+				},
+				{
+					// Changing only wait_for_status waits for the new status
+					Config:      testAccResourceConnectorAgentConfigWaitForStatus(rName, "disconnected", "1s"),
+					ExpectError: regexp.MustCompile(`did not report status "disconnected"`),
+				},
+				{
+					Config: testAccResourceConnectorAgentConfigInstanceID(rName, rName),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckNoResourceAttr(testConnectorAgentResourceName, "wait_for_status"),
+					),
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+// seedConnectorAgent registers a connector agent in the fake API. The
+// hostname tests rely on its hostname matching the instance ID.
+func seedConnectorAgent(f *fakeAPIServer) string {
+	return f.SeedConnectorAgent(testConnectorAgentNamePrefix)
+}
+
 // buildConnectorAgentInstanceIDStateChecks returns state checks for instance ID-based configuration
 func buildConnectorAgentInstanceIDStateChecks(instanceID string) []statecheck.StateCheck {
 	return []statecheck.StateCheck{
@@ -163,6 +193,19 @@ resource "ciscosecureaccess_resource_connector_agent" "test_agent" {
 }`, instanceID, enabled)
 }
 
+// testAccResourceConnectorAgentConfigWaitForStatus generates Terraform configuration waiting for a connector status
+func testAccResourceConnectorAgentConfigWaitForStatus(instanceID, status, updateTimeout string) string {
+	return fmt.Sprintf(`
+resource "ciscosecureaccess_resource_connector_agent" "test_agent" {
+  instance_id     = "%s"
+  wait_for_status = "%s"
+
+  timeouts {
+    update = "%s"
+  }
+}`, instanceID, status, updateTimeout)
+}
+
 // testAccResourceConnectorAgentConfigConfirmed generates Terraform configuration for confirmation tests
 func testAccResourceConnectorAgentConfigConfirmed(name, instanceID string, confirmed bool) string {
 	return fmt.Sprintf(`
@@ -174,10 +217,7 @@ resource "ciscosecureaccess_resource_connector_agent" "test_agent" {
 
 func testAccCheckResourceConnectorAgentDestroy(s *terraform.State) error {
 	ctx := context.Background()
-	factory := &client.SSEClientFactory{
-		KeyId:     os.Getenv("CISCOSECUREACCESS_KEY_ID"),
-		KeySecret: os.Getenv("CISCOSECUREACCESS_KEY_SECRET"),
-	}
+	factory := testAccClientFactory()
 	c := factory.GetResConnClient(ctx)
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ciscosecureaccess_resource_connector_agent" {
@@ -191,3 +231,40 @@ func testAccCheckResourceConnectorAgentDestroy(s *terraform.State) error {
 	}
 	return nil
 }
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+func TestWaitForAgentStatus(t *testing.T) {
+	fake := newFakeAPIServer()
+	defer fake.Close()
+
+	factory := &client.SSEClientFactory{
+		KeyId:         fakeAPIKeyID,
+		KeySecret:     fakeAPIKeySecret,
+		ApiEndpoint:   fake.Endpoint(),
+		SSEHttpClient: fake.HTTPClient(),
+	}
+	apiClient := *factory.GetResConnClient(context.Background())
+	groupID := fake.SeedConnectorGroup("tf-test-agent-status")
+	fake.SeedGroupConnector(groupID, "i-agent", "connected")
+	connectors, err := listGroupConnectors(context.Background(), apiClient, groupID)
+	if err != nil || len(connectors) != 1 {
+		t.Fatalf("listing seeded connector: %v", err)
+	}
+	agentID := connectors[0].GetId()
+
+	agent, err := waitForAgentStatus(context.Background(), apiClient, agentID, "connected")
+	if err != nil {
+		t.Fatalf("waitForAgentStatus: %v", err)
+	}
+	if agent.GetInstanceId() != "i-agent" {
+		t.Errorf("instance ID = %q, want i-agent", agent.GetInstanceId())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = waitForAgentStatus(ctx, apiClient, agentID, "disconnected")
+	if err == nil || !strings.Contains(err.Error(), `reports status "connected", waiting for "disconnected"`) {
+		t.Errorf("expected the reported status in the error, got: %v", err)
+	}
+}
@@ -0,0 +1,558 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	pathpkg "path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/CiscoDevNet/go-ciscosecureaccess/resconn"
+	"github.com/avast/retry-go/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &resourceConnectorAgentsResource{}
+	_ resource.ResourceWithConfigure        = &resourceConnectorAgentsResource{}
+	_ resource.ResourceWithConfigValidators = &resourceConnectorAgentsResource{}
+	_ resource.ResourceWithValidateConfig   = &resourceConnectorAgentsResource{}
+	_ resource.ResourceWithModifyPlan       = &resourceConnectorAgentsResource{}
+)
+
+// NewResourceConnectorAgentsResource is a helper function to simplify the provider implementation.
+func NewResourceConnectorAgentsResource() resource.Resource {
+	return &resourceConnectorAgentsResource{}
+}
+
+// resourceConnectorAgentsResource manages every agent of a connector group
+// that matches a set of instance IDs or hostname patterns
+type resourceConnectorAgentsResource struct {
+	client resconn.APIClient
+}
+
+// resourceConnectorAgentsResourceModel maps the resource schema data.
+type resourceConnectorAgentsResourceModel struct {
+	ID               types.String        `tfsdk:"id"`
+	GroupID          types.Int64         `tfsdk:"group_id"`
+	InstanceIDs      types.Set           `tfsdk:"instance_ids"`
+	HostnamePatterns types.Set           `tfsdk:"hostname_patterns"`
+	Confirmed        types.Bool          `tfsdk:"confirmed"`
+	Enabled          types.Bool          `tfsdk:"enabled"`
+	Agents           types.List          `tfsdk:"agents"`
+	WaitFor          *connectorWaitModel `tfsdk:"wait_for"`
+	Timeouts         timeouts.Value      `tfsdk:"timeouts"`
+}
+
+// connectorAgentModel maps one element of the agents attribute.
+type connectorAgentModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	InstanceID types.String `tfsdk:"instance_id"`
+	Hostname   types.String `tfsdk:"hostname"`
+	Status     types.String `tfsdk:"status"`
+	Confirmed  types.Bool   `tfsdk:"confirmed"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+}
+
+func (m connectorAgentModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.Int64Type,
+		"instance_id": types.StringType,
+		"hostname":    types.StringType,
+		"status":      types.StringType,
+		"confirmed":   types.BoolType,
+		"enabled":     types.BoolType,
+	}
+}
+
+// connectorAgentSelector matches agents by instance ID or hostname pattern
+type connectorAgentSelector struct {
+	InstanceIDs      []string
+	HostnamePatterns []string
+}
+
+// Metadata returns the resource type name.
+func (r *resourceConnectorAgentsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_connector_agents"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resourceConnectorAgentsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	factory, ok := req.ProviderData.(*client.SSEClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data Type",
+			fmt.Sprintf("expected *client.SSEClientFactory, got %T", req.ProviderData))
+		return
+	}
+	r.client = *factory.GetResConnClient(ctx)
+}
+
+// Schema defines the schema for the resource.
+func (r *resourceConnectorAgentsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Confirms, enables or disables every resource connector agent of a group that matches a set of instance IDs or hostname patterns, " +
+			"such as the connector virtual machines of an autoscaling group. Agents that stop matching are deleted from the group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the resource connector group followed by a hash of instance_ids and hostname_patterns, so several resources can manage disjoint agents of one group",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.Int64Attribute{
+				Description: "ID of the resource connector group. Changing it forces a new resource.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"instance_ids": schema.SetAttribute{
+				Description: "Instance IDs of the agents to manage. The apply waits, up to the create or update timeout, until every listed agent has registered.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"hostname_patterns": schema.SetAttribute{
+				Description: "Shell patterns, such as rc-prod-*, matched against agent hostnames. Agents registered later are managed on the next apply.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"confirmed": schema.BoolAttribute{
+				Description: "Whether or not to confirm the matching resource connectors. Left unchanged when unset.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether or not to enable the matching resource connectors. Left unchanged when unset.",
+				Optional:    true,
+			},
+			"agents": schema.ListNestedAttribute{
+				Description: "Resource connector agents matched by instance_ids or hostname_patterns, sorted by instance ID",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Unique ID of resource connector agent",
+							Computed:    true,
+						},
+						"instance_id": schema.StringAttribute{
+							Description: "Instance ID of resource connector agent",
+							Computed:    true,
+						},
+						"hostname": schema.StringAttribute{
+							Description: "Hostname of resource connector agent",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of resource connector agent",
+							Computed:    true,
+						},
+						"confirmed": schema.BoolAttribute{
+							Description: "Whether resource connector agent is confirmed",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether resource connector agent is enabled",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"wait_for": connectorWaitAttribute("Block the apply until connectors of the group report a status, for example to publish private resources only once " +
+				"their connectors are up. The wait is bounded by the create and update timeouts, and the error lists the connectors that are not ready."),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *resourceConnectorAgentsResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("instance_ids"),
+			path.MatchRoot("hostname_patterns"),
+		),
+	}
+}
+
+// ValidateConfig checks the syntax of hostname_patterns.
+func (r *resourceConnectorAgentsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var patterns []types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("hostname_patterns"), &patterns)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, pattern := range patterns {
+		if pattern.IsNull() || pattern.IsUnknown() {
+			continue
+		}
+		if _, err := pathpkg.Match(pattern.ValueString(), ""); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("hostname_patterns"),
+				"Invalid Hostname Pattern",
+				fmt.Sprintf("%q is not a valid shell pattern: %s", pattern.ValueString(), err.Error()),
+			)
+		}
+	}
+}
+
+// ModifyPlan plans an update when a managed agent no longer has the
+// configured confirmed or enabled value, for example a connector registered
+// by an autoscaling group since the last apply.
+func (r *resourceConnectorAgentsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state resourceConnectorAgentsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var agents []connectorAgentModel
+	resp.Diagnostics.Append(state.Agents.ElementsAs(ctx, &agents, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed := !plan.InstanceIDs.Equal(state.InstanceIDs) || !plan.HostnamePatterns.Equal(state.HostnamePatterns)
+	for _, agent := range agents {
+		if !plan.Confirmed.IsNull() && !agent.Confirmed.Equal(plan.Confirmed) || !plan.Enabled.IsNull() && !agent.Enabled.Equal(plan.Enabled) {
+			changed = true
+		}
+	}
+	if !plan.InstanceIDs.Equal(state.InstanceIDs) || !plan.HostnamePatterns.Equal(state.HostnamePatterns) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	}
+	if changed {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("agents"), types.ListUnknown(types.ObjectType{AttrTypes: connectorAgentModel{}.AttrTypes()}))...)
+	}
+}
+
+// Create configures the matching agents and sets the initial Terraform state.
+func (r *resourceConnectorAgentsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceConnectorAgentsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the agents matching the configuration.
+func (r *resourceConnectorAgentsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceConnectorAgentsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	selector, diags := newConnectorAgentSelector(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectors, err := listGroupConnectors(ctx, r.client, state.GroupID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading resource connector agents",
+			fmt.Sprintf("Could not list the agents of resource connector group %d: %s", state.GroupID.ValueInt64(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setConnectorAgentsState(ctx, selector.match(connectors), &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update configures the matching agents and deletes the agents that no
+// longer match.
+func (r *resourceConnectorAgentsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceConnectorAgentsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes every managed agent from the group.
+func (r *resourceConnectorAgentsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceConnectorAgentsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	var agents []connectorAgentModel
+	resp.Diagnostics.Append(state.Agents.ElementsAs(ctx, &agents, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, agent := range agents {
+		if err := deleteConnectorAgent(ctx, r.client, agent.ID.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting resource connector agent",
+				fmt.Sprintf("Could not delete resource connector agent %s: %s", agent.InstanceID.ValueString(), err.Error()),
+			)
+		}
+	}
+}
+
+// apply waits for the agents of plan, sets their confirmed and enabled
+// values and, on update, deletes the agents of prior that no longer match
+func (r *resourceConnectorAgentsResource) apply(ctx context.Context, plan, prior *resourceConnectorAgentsResourceModel, diagnostics *diag.Diagnostics) {
+	groupID := plan.GroupID.ValueInt64()
+	selector, diags := newConnectorAgentSelector(ctx, *plan)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(connectorAgentsID(groupID, selector))
+
+	if plan.WaitFor != nil {
+		if err := waitForConnectors(ctx, r.client, newConnectorReadiness(ctx, groupID, *plan.WaitFor)); err != nil {
+			diagnostics.AddAttributeError(
+				path.Root("wait_for"),
+				"Resource Connectors Not Ready",
+				fmt.Sprintf("Resource connector group %d did not become ready before the timeout: %s", groupID, err.Error()),
+			)
+			return
+		}
+	}
+
+	// Every listed instance must have registered, which takes a few minutes
+	// after a connector virtual machine boots
+	var matched []resconn.ConnectorResponse
+	err := retry.Do(
+		func() error {
+			connectors, err := listGroupConnectors(ctx, r.client, groupID)
+			if err != nil {
+				return err
+			}
+			matched = selector.match(connectors)
+			if missing := selector.missing(matched); len(missing) > 0 {
+				return fmt.Errorf("agents never appeared in resource connector group %d: %s", groupID, strings.Join(missing, ", "))
+			}
+			return nil
+		},
+		retryUntilDeadline(ctx, connectorRetryBaseDelay)...,
+	)
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("instance_ids"),
+			"Could not retrieve resource connector agents",
+			err.Error(),
+		)
+		return
+	}
+
+	for i, agent := range matched {
+		if !plan.Confirmed.IsNull() && agent.GetConfirmed() != plan.Confirmed.ValueBool() {
+			if err := patchConnectorField(ctx, r.client, agent.GetId(), connectorPatchPathConfirmed, plan.Confirmed.ValueBoolPointer()); err != nil {
+				diagnostics.AddError("Error updating resource connector agent",
+					fmt.Sprintf("Failed to update confirmed status of resource connector agent %s: %s", agent.GetInstanceId(), err.Error()))
+				return
+			}
+			matched[i].SetConfirmed(plan.Confirmed.ValueBool())
+		}
+		if !plan.Enabled.IsNull() && agent.GetEnabled() != plan.Enabled.ValueBool() {
+			if err := patchConnectorField(ctx, r.client, agent.GetId(), connectorPatchPathEnabled, plan.Enabled.ValueBoolPointer()); err != nil {
+				diagnostics.AddError("Error updating resource connector agent",
+					fmt.Sprintf("Failed to update enabled status of resource connector agent %s: %s", agent.GetInstanceId(), err.Error()))
+				return
+			}
+			matched[i].SetEnabled(plan.Enabled.ValueBool())
+		}
+	}
+
+	if prior != nil {
+		var previous []connectorAgentModel
+		diagnostics.Append(prior.Agents.ElementsAs(ctx, &previous, false)...)
+		if diagnostics.HasError() {
+			return
+		}
+		keep := make(map[int64]bool, len(matched))
+		for _, agent := range matched {
+			keep[agent.GetId()] = true
+		}
+		for _, agent := range previous {
+			if keep[agent.ID.ValueInt64()] {
+				continue
+			}
+			tflog.Info(ctx, "Deleting resource connector agent that is no longer listed", map[string]interface{}{
+				"instance_id": agent.InstanceID.ValueString(),
+			})
+			if err := deleteConnectorAgent(ctx, r.client, agent.ID.ValueInt64()); err != nil {
+				diagnostics.AddError(
+					"Error deleting resource connector agent",
+					fmt.Sprintf("Could not delete resource connector agent %s: %s", agent.InstanceID.ValueString(), err.Error()),
+				)
+				return
+			}
+		}
+	}
+
+	diagnostics.Append(setConnectorAgentsState(ctx, matched, plan)...)
+}
+
+// newConnectorAgentSelector reads instance_ids and hostname_patterns
+func newConnectorAgentSelector(ctx context.Context, data resourceConnectorAgentsResourceModel) (connectorAgentSelector, diag.Diagnostics) {
+	var selector connectorAgentSelector
+	var diags diag.Diagnostics
+	if !data.InstanceIDs.IsNull() {
+		diags.Append(data.InstanceIDs.ElementsAs(ctx, &selector.InstanceIDs, false)...)
+	}
+	if !data.HostnamePatterns.IsNull() {
+		diags.Append(data.HostnamePatterns.ElementsAs(ctx, &selector.HostnamePatterns, false)...)
+	}
+	sort.Strings(selector.InstanceIDs)
+	sort.Strings(selector.HostnamePatterns)
+	return selector, diags
+}
+
+// connectorAgentsID identifies the agents of groupID picked by selector, so
+// resources selecting different agents of one group get different IDs
+func connectorAgentsID(groupID int64, selector connectorAgentSelector) string {
+	sum := sha256.Sum256([]byte(strings.Join(selector.InstanceIDs, "\x00") + "\x01" + strings.Join(selector.HostnamePatterns, "\x00")))
+	return strconv.FormatInt(groupID, 10) + "/" + hex.EncodeToString(sum[:])[:12]
+}
+
+// match returns the connectors selected by instance ID or hostname pattern,
+// sorted by instance ID
+func (s connectorAgentSelector) match(connectors []resconn.ConnectorResponse) []resconn.ConnectorResponse {
+	var matched []resconn.ConnectorResponse
+	for _, connector := range connectors {
+		if s.matches(connector) {
+			matched = append(matched, connector)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].GetInstanceId() < matched[j].GetInstanceId()
+	})
+	return matched
+}
+
+func (s connectorAgentSelector) matches(connector resconn.ConnectorResponse) bool {
+	for _, instanceID := range s.InstanceIDs {
+		if strings.EqualFold(connector.GetInstanceId(), instanceID) {
+			return true
+		}
+	}
+	for _, pattern := range s.HostnamePatterns {
+		if ok, _ := pathpkg.Match(pattern, connector.GetHostname()); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// missing returns the listed instance IDs that are not among matched
+func (s connectorAgentSelector) missing(matched []resconn.ConnectorResponse) []string {
+	var missing []string
+	for _, instanceID := range s.InstanceIDs {
+		found := false
+		for _, connector := range matched {
+			found = found || strings.EqualFold(connector.GetInstanceId(), instanceID)
+		}
+		if !found {
+			missing = append(missing, instanceID)
+		}
+	}
+	return missing
+}
+
+// setConnectorAgentsState copies the matched agents into state
+func setConnectorAgentsState(ctx context.Context, agents []resconn.ConnectorResponse, state *resourceConnectorAgentsResourceModel) diag.Diagnostics {
+	models := make([]connectorAgentModel, 0, len(agents))
+	for _, agent := range agents {
+		models = append(models, connectorAgentModel{
+			ID:         types.Int64Value(agent.GetId()),
+			InstanceID: types.StringValue(agent.GetInstanceId()),
+			Hostname:   types.StringValue(agent.GetHostname()),
+			Status:     types.StringValue(agent.GetStatus()),
+			Confirmed:  types.BoolValue(agent.GetConfirmed()),
+			Enabled:    types.BoolValue(agent.GetEnabled()),
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: connectorAgentModel{}.AttrTypes()}, models)
+	state.Agents = list
+	return diags
+}
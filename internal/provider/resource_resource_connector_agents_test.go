@@ -0,0 +1,132 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/resconn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+const testConnectorAgentsResourceName = "ciscosecureaccess_resource_connector_agents.test"
+
+func TestResourceConnectorAgentsResource_hostnamePatterns(t *testing.T) {
+	groupID := testAccFixture(t, "TEST_CISCOSECUREACCESS_CONNECTOR_GROUP_ID", seedConnectorAgentsGroup)
+	rateLimitedTest(t, func() {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccConnectorAgentsConfig(groupID),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr(testConnectorAgentsResourceName, "id", regexp.MustCompile(`^`+groupID+`/[0-9a-f]{12}$`)),
+						resource.TestCheckResourceAttrSet(testConnectorAgentsResourceName, "agents.0.id"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(testConnectorAgentsResourceName, tfjsonpath.New("agents").AtSliceIndex(0).AtMapKey("confirmed"),
+							knownvalue.Bool(true)),
+					},
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+// seedConnectorAgentsGroup seeds a group with two connectors for the fake API
+func seedConnectorAgentsGroup(f *fakeAPIServer) string {
+	groupID := f.SeedConnectorGroup(generateConnectorGroupTestName("agents"))
+	f.SeedGroupConnector(groupID, "rc-agents-1", "connected")
+	f.SeedGroupConnector(groupID, "rc-agents-2", "connected")
+	return strconv.FormatInt(groupID, 10)
+}
+
+func testAccConnectorAgentsConfig(groupID string) string {
+	return fmt.Sprintf(`
+resource "ciscosecureaccess_resource_connector_agents" "test" {
+  group_id          = %s
+  hostname_patterns = ["*"]
+  confirmed         = true
+}
+`, groupID)
+}
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+func TestConnectorAgentSelector(t *testing.T) {
+	connector := func(instanceID, hostname string) resconn.ConnectorResponse {
+		c := resconn.NewConnectorResponse()
+		c.SetInstanceId(instanceID)
+		c.SetHostname(hostname)
+		return *c
+	}
+	connectors := []resconn.ConnectorResponse{
+		connector("i-3", "rc-prod-b"),
+		connector("i-1", "rc-prod-a"),
+		connector("i-2", "rc-dev-a"),
+	}
+
+	selector, diags := newConnectorAgentSelector(context.Background(), resourceConnectorAgentsResourceModel{
+		InstanceIDs:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("i-2"), types.StringValue("i-4")}),
+		HostnamePatterns: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("rc-prod-*")}),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	matched := selector.match(connectors)
+	if len(matched) != 3 || matched[0].GetInstanceId() != "i-1" || matched[2].GetInstanceId() != "i-3" {
+		t.Errorf("unexpected matches: %+v", matched)
+	}
+	if missing := selector.missing(matched); len(missing) != 1 || missing[0] != "i-4" {
+		t.Errorf("unexpected missing instance IDs: %v", missing)
+	}
+}
+
+func TestSetConnectorAgentsState(t *testing.T) {
+	agent := resconn.NewConnectorResponse()
+	agent.SetId(9)
+	agent.SetInstanceId("i-1")
+	agent.SetHostname("rc-prod-a")
+	agent.SetStatus("connected")
+	agent.SetConfirmed(true)
+
+	var state resourceConnectorAgentsResourceModel
+	if diags := setConnectorAgentsState(context.Background(), []resconn.ConnectorResponse{*agent}, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var agents []connectorAgentModel
+	state.Agents.ElementsAs(context.Background(), &agents, false)
+	if len(agents) != 1 || agents[0].ID.ValueInt64() != 9 || !agents[0].Confirmed.ValueBool() || agents[0].Enabled.ValueBool() {
+		t.Errorf("unexpected agents: %+v", agents)
+	}
+}
+
+func TestConnectorAgentsID(t *testing.T) {
+	byInstance := connectorAgentSelector{InstanceIDs: []string{"i-1", "i-2"}}
+	byHostname := connectorAgentSelector{HostnamePatterns: []string{"i-1", "i-2"}}
+
+	if connectorAgentsID(7, byInstance) != connectorAgentsID(7, byInstance) {
+		t.Error("expected the same selector to keep its ID")
+	}
+	if connectorAgentsID(7, byInstance) == connectorAgentsID(7, byHostname) {
+		t.Error("expected different selectors of one group to get different IDs")
+	}
+	if id := connectorAgentsID(7, byInstance); !strings.HasPrefix(id, "7/") {
+		t.Errorf("expected the ID to start with the group ID, got %q", id)
+	}
+}
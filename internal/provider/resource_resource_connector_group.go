@@ -0,0 +1,481 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
+	"github.com/CiscoDevNet/go-ciscosecureaccess/resconn"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &resourceConnectorGroupResource{}
+	_ resource.ResourceWithConfigure   = &resourceConnectorGroupResource{}
+	_ resource.ResourceWithImportState = &resourceConnectorGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &resourceConnectorGroupResource{}
+)
+
+// connectorGroupNamePattern matches the names the API accepts for connector
+// groups
+var connectorGroupNamePattern = regexp.MustCompile(`^[a-zA-Z0-9- ]+$`)
+
+// NewResourceConnectorGroupResource is a helper function to simplify the provider implementation.
+func NewResourceConnectorGroupResource() resource.Resource {
+	return &resourceConnectorGroupResource{}
+}
+
+// resourceConnectorGroupResource is the resource implementation.
+type resourceConnectorGroupResource struct {
+	client resconn.APIClient
+}
+
+// resourceConnectorGroupResourceModel maps the resource schema data.
+type resourceConnectorGroupResourceModel struct {
+	ID                     types.Int64         `tfsdk:"id"`
+	Name                   types.String        `tfsdk:"name"`
+	Location               types.String        `tfsdk:"location"`
+	Environment            types.String        `tfsdk:"environment"`
+	ProvisioningKey        types.String        `tfsdk:"provisioning_key"`
+	KeyExpiresAt           types.String        `tfsdk:"key_expires_at"`
+	ProvisioningKeyVersion types.Int64         `tfsdk:"provisioning_key_version"`
+	Status                 types.String        `tfsdk:"status"`
+	ConnectorsCount        types.Int64         `tfsdk:"connectors_count"`
+	WaitFor                *connectorWaitModel `tfsdk:"wait_for"`
+	Timeouts               timeouts.Value      `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *resourceConnectorGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_connector_group"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resourceConnectorGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	factory, ok := req.ProviderData.(*client.SSEClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data Type",
+			fmt.Sprintf("expected *client.SSEClientFactory, got %T", req.ProviderData))
+		return
+	}
+	r.client = *factory.GetResConnClient(ctx)
+}
+
+// Schema defines the schema for the resource.
+func (r *resourceConnectorGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	environments := make([]string, 0, len(resconn.AllowedEnvironmentEnumValues))
+	for _, environment := range resconn.AllowedEnvironmentEnumValues {
+		environments = append(environments, string(environment))
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Resource Connector Group, a set of resource connectors deployed in one location that connect Secure Access to private resources",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "Unique ID of resource connector group",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of resource connector group, made of letters, digits, spaces and hyphens",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(connectorGroupNamePattern, "must only contain letters, digits, spaces and hyphens"),
+				},
+			},
+			"location": schema.StringAttribute{
+				Description: "Location where resource connector group is provisioned, such as the cloud region of the connectors",
+				Required:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: fmt.Sprintf("Environment in which resource connector group is provisioned, one of: %s. Defaults to %s. Changing it forces a new resource.",
+					strings.Join(environments, ", "), resconn.AWS),
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(environments...),
+				},
+				PlanModifiers: []planmodifier.String{
+					StringDefaultValue(string(resconn.AWS)),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provisioning_key": schema.StringAttribute{
+				Description: "Provisioning key for adding resource connectors to group. It is stored in state; use the " +
+					"ciscosecureaccess_resource_connector_provisioning_key ephemeral resource to keep it out of state",
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_expires_at": schema.StringAttribute{
+				Description: "Time at which resource connector group provisioning key next expires",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provisioning_key_version": schema.Int64Attribute{
+				Description: "Arbitrary number that rotates the provisioning key whenever it changes. Connectors already registered " +
+					"keep working, but the previous key can no longer register new connectors.",
+				Optional: true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of resource connector group",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connectors_count": schema.Int64Attribute{
+				Description: "Number of resource connectors in group",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_for": connectorWaitAttribute("Block updates of the group, after the provisioning key is rotated, until its connectors report a status. " +
+				"Not applied when the group is created, since connectors register with its provisioning key; wait for new connectors with " +
+				"ciscosecureaccess_resource_connector_agents instead. The wait is bounded by the update timeout, and the error lists the connectors that are not ready."),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// ModifyPlan warns that wait_for is not applied when the group is created,
+// marks the provisioning key unknown when provisioning_key_version changes,
+// since the update rotates it, and marks status and connectors_count unknown
+// when an update waits for the connectors.
+func (r *resourceConnectorGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	if req.State.Raw.IsNull() {
+		var waitFor types.Object
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("wait_for"), &waitFor)...)
+		if !waitFor.IsNull() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("wait_for"),
+				"Connectors Not Waited For On Create",
+				"Connectors register with the provisioning key of the group, which only exists once the group is created, so wait_for applies to "+
+					"later updates of the group only. Use wait_for of a ciscosecureaccess_resource_connector_agents resource to wait for the "+
+					"connectors deployed with the new key.",
+			)
+		}
+		return
+	}
+
+	var plan, state resourceConnectorGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.WaitFor != nil && !req.Plan.Raw.Equal(req.State.Raw) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("connectors_count"), types.Int64Unknown())...)
+	}
+	if !plan.ProvisioningKeyVersion.Equal(state.ProvisioningKeyVersion) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("provisioning_key"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("key_expires_at"), types.StringUnknown())...)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *resourceConnectorGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceConnectorGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, "Creating resource connector group", map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	})
+
+	group, _, err := r.client.ConnectorGroupsAPI.CreateConnectorGroup(ctx).
+		ConnectorGroupReq(buildConnectorGroupRequest(plan)).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Resource Connector Group",
+			fmt.Sprintf("Could not create resource connector group %q: %s", plan.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	groupID := group.GetId()
+	tflog.Debug(ctx, "Created resource connector group", map[string]interface{}{"id": groupID})
+
+	// Save the ID before reading the key back, so a failed read does not
+	// orphan the group
+	plan.ID = types.Int64Value(groupID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+
+	// wait_for is not applied here: connectors cannot register before the
+	// provisioning key read below is handed to them
+
+	group, _, err = r.client.ConnectorGroupsAPI.GetConnectorGroup(ctx, groupID).IncludeProvisioningKey(true).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Resource Connector Group",
+			fmt.Sprintf("Could not read resource connector group %d after creating it: %s", groupID, err.Error()),
+		)
+		return
+	}
+
+	setConnectorGroupState(group, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *resourceConnectorGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceConnectorGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	groupID := state.ID.ValueInt64()
+	tflog.Debug(ctx, "Reading resource connector group", map[string]interface{}{"id": groupID})
+
+	group, httpRes, err := r.client.ConnectorGroupsAPI.GetConnectorGroup(ctx, groupID).IncludeProvisioningKey(true).Execute()
+	if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+		tflog.Info(ctx, "Resource connector group not found, removing from state", map[string]interface{}{"id": groupID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Resource Connector Group",
+			fmt.Sprintf("Could not read resource connector group %d: %s", groupID, err.Error()),
+		)
+		return
+	}
+
+	setConnectorGroupState(group, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update renames or relocates the group, rotates its provisioning key when
+// provisioning_key_version changed and then waits for the connectors.
+func (r *resourceConnectorGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceConnectorGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	groupID := state.ID.ValueInt64()
+
+	if !plan.Name.Equal(state.Name) || !plan.Location.Equal(state.Location) {
+		tflog.Info(ctx, "Updating resource connector group", map[string]interface{}{"id": groupID})
+		_, _, err := r.client.ConnectorGroupsAPI.PutConnectorGroup(ctx, groupID).
+			ConnectorGroupReq(buildConnectorGroupRequest(plan)).
+			Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating Resource Connector Group",
+				fmt.Sprintf("Could not update resource connector group %d: %s", groupID, err.Error()),
+			)
+			return
+		}
+	}
+
+	rotate := !plan.ProvisioningKeyVersion.Equal(state.ProvisioningKeyVersion)
+	group, err := getProvisioningKey(ctx, r.client, groupID, rotate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Resource Connector Group provisioning key",
+			fmt.Sprintf("Could not read the provisioning key of resource connector group %d: %s", groupID, err.Error()),
+		)
+		return
+	}
+
+	// Without wait_for, status and connectors_count keep their planned
+	// values from state until the next refresh
+	status, connectorsCount := plan.Status, plan.ConnectorsCount
+	setConnectorGroupState(group, &plan)
+	if plan.WaitFor == nil {
+		plan.Status, plan.ConnectorsCount = status, connectorsCount
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	// Save the rotated key before waiting, so a timeout does not lose it
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !r.waitForConnectors(ctx, groupID, plan.WaitFor, &resp.Diagnostics) {
+		return
+	}
+
+	group, _, err = r.client.ConnectorGroupsAPI.GetConnectorGroup(ctx, groupID).IncludeProvisioningKey(true).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Resource Connector Group",
+			fmt.Sprintf("Could not read resource connector group %d after waiting for its connectors: %s", groupID, err.Error()),
+		)
+		return
+	}
+	setConnectorGroupState(group, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *resourceConnectorGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceConnectorGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	groupID := state.ID.ValueInt64()
+	tflog.Info(ctx, "Deleting resource connector group", map[string]interface{}{"id": groupID})
+
+	_, httpRes, err := r.client.ConnectorGroupsAPI.DeleteConnectorGroup(ctx, groupID).Execute()
+	if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Resource Connector Group",
+			fmt.Sprintf("Could not delete resource connector group %d, connectors must be removed from the group first: %s", groupID, err.Error()),
+		)
+	}
+}
+
+// waitForConnectors blocks until the connectors of the group satisfy wait,
+// when it is set. It reports false after adding an error to diagnostics.
+func (r *resourceConnectorGroupResource) waitForConnectors(ctx context.Context, groupID int64, wait *connectorWaitModel, diagnostics *diag.Diagnostics) bool {
+	if wait == nil {
+		return true
+	}
+	if err := waitForConnectors(ctx, r.client, newConnectorReadiness(ctx, groupID, *wait)); err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("wait_for"),
+			"Resource Connectors Not Ready",
+			fmt.Sprintf("Resource connector group %d did not become ready before the timeout: %s", groupID, err.Error()),
+		)
+		return false
+	}
+	return true
+}
+
+// ImportState imports a connector group by ID or by name.
+func (r *resourceConnectorGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := resolveImportID(ctx, req.ID, "resource connector group", r.findConnectorGroupsByName)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// findConnectorGroupsByName returns the IDs of all connector groups named
+// name.
+func (r *resourceConnectorGroupResource) findConnectorGroupsByName(ctx context.Context, name string) ([]int64, error) {
+	var ids []int64
+	offset := int64(0)
+	for {
+		listResp, _, err := r.client.ConnectorGroupsAPI.ListConnectorGroups(ctx).Offset(offset).Limit(importLookupPageLimit).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range listResp.Data {
+			if group.GetName() == name {
+				ids = append(ids, group.GetId())
+			}
+		}
+
+		offset += int64(len(listResp.Data))
+		if len(listResp.Data) == 0 || offset >= listResp.GetTotal() {
+			return ids, nil
+		}
+	}
+}
+
+// buildConnectorGroupRequest converts the plan to the create and update
+// request body
+func buildConnectorGroupRequest(plan resourceConnectorGroupResourceModel) resconn.ConnectorGroupReq {
+	request := *resconn.NewConnectorGroupReq(plan.Name.ValueString(), plan.Location.ValueString())
+	if !plan.Environment.IsNull() && !plan.Environment.IsUnknown() {
+		request.SetEnvironment(resconn.Environment(plan.Environment.ValueString()))
+	}
+	return request
+}
+
+// setConnectorGroupState copies a connector group read with its provisioning
+// key into state
+func setConnectorGroupState(group *resconn.ConnectorGroupResponse, state *resourceConnectorGroupResourceModel) {
+	state.ID = types.Int64Value(group.GetId())
+	state.Name = types.StringValue(group.GetName())
+	state.Location = types.StringValue(group.GetLocation())
+	state.Environment = types.StringValue(string(group.GetEnvironment()))
+	state.ProvisioningKey = types.StringValue(group.GetProvisioningKey())
+	state.KeyExpiresAt = types.StringValue(group.GetProvisioningKeyExpiresAt().Format(time.RFC3339))
+	state.Status = types.StringValue(group.GetStatus())
+	state.ConnectorsCount = types.Int64Value(group.GetConnectorsCount())
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevNet/go-ciscosecureaccess/resconn"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+const testConnectorGroupResourceName = "ciscosecureaccess_resource_connector_group.test"
+
+func generateConnectorGroupTestName(suffix string) string {
+	return fmt.Sprintf("tf-test-%s-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum), suffix)
+}
+
+func TestResourceConnectorGroupResource_basic(t *testing.T) {
+	rateLimitedTest(t, func() {
+		name := generateConnectorGroupTestName("basic")
+		renamed := generateConnectorGroupTestName("renamed")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			CheckDestroy:             testAccCheckConnectorGroupDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccConnectorGroupConfig(name, "us-west-2", 0),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet(testConnectorGroupResourceName, "id"),
+						resource.TestCheckResourceAttrSet(testConnectorGroupResourceName, "provisioning_key"),
+						resource.TestCheckResourceAttrSet(testConnectorGroupResourceName, "key_expires_at"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(testConnectorGroupResourceName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+						statecheck.ExpectKnownValue(testConnectorGroupResourceName, tfjsonpath.New("location"), knownvalue.StringExact("us-west-2")),
+						statecheck.ExpectKnownValue(testConnectorGroupResourceName, tfjsonpath.New("environment"), knownvalue.StringExact("aws")),
+					},
+				},
+				{
+					ResourceName:      testConnectorGroupResourceName,
+					ImportState:       true,
+					ImportStateVerify: true,
+					// The version is a configuration-only rotation trigger
+					ImportStateVerifyIgnore: []string{"provisioning_key_version"},
+				},
+				{
+					Config: testAccConnectorGroupConfig(renamed, "us-east-1", 0),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(testConnectorGroupResourceName, plancheck.ResourceActionUpdate),
+						},
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(testConnectorGroupResourceName, tfjsonpath.New("name"), knownvalue.StringExact(renamed)),
+						statecheck.ExpectKnownValue(testConnectorGroupResourceName, tfjsonpath.New("location"), knownvalue.StringExact("us-east-1")),
+					},
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+func TestResourceConnectorGroupResource_rotateProvisioningKey(t *testing.T) {
+	rateLimitedTest(t, func() {
+		name := generateConnectorGroupTestName("rotate")
+		keys := statecheck.CompareValue(compare.ValuesDiffer())
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccCiscoSecureAccessProviderFactories,
+			CheckDestroy:             testAccCheckConnectorGroupDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccConnectorGroupConfig(name, "us-west-2", 1),
+					ConfigStateChecks: []statecheck.StateCheck{
+						keys.AddStateValue(testConnectorGroupResourceName, tfjsonpath.New("provisioning_key")),
+					},
+				},
+				{
+					Config: testAccConnectorGroupConfig(name, "us-west-2", 2),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(testConnectorGroupResourceName, plancheck.ResourceActionUpdate),
+							plancheck.ExpectUnknownValue(testConnectorGroupResourceName, tfjsonpath.New("provisioning_key")),
+							// Without wait_for, status and connectors_count keep their state
+							plancheck.ExpectKnownValue(testConnectorGroupResourceName, tfjsonpath.New("connectors_count"), knownvalue.Int64Exact(0)),
+						},
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						keys.AddStateValue(testConnectorGroupResourceName, tfjsonpath.New("provisioning_key")),
+					},
+				},
+			},
+		})
+	}, minWaitTime)
+}
+
+// --- Unit tests (hermetic, no credentials required) ---
+
+func TestBuildConnectorGroupRequest(t *testing.T) {
+	request := buildConnectorGroupRequest(resourceConnectorGroupResourceModel{
+		Name:        types.StringValue("Branch Connectors"),
+		Location:    types.StringValue("eastus"),
+		Environment: types.StringValue("azure"),
+	})
+	if request.Name != "Branch Connectors" || request.Location != "eastus" || request.GetEnvironment() != resconn.AZURE {
+		t.Errorf("unexpected request: %+v", request)
+	}
+}
+
+func TestSetConnectorGroupState(t *testing.T) {
+	expires := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	group := resconn.NewConnectorGroupResponse()
+	group.SetId(42)
+	group.SetName("Branch Connectors")
+	group.SetLocation("us-west-2")
+	group.SetEnvironment(resconn.ESX)
+	group.SetProvisioningKey("key")
+	group.SetProvisioningKeyExpiresAt(expires)
+	group.SetStatus("connected")
+	group.SetConnectorsCount(3)
+
+	var state resourceConnectorGroupResourceModel
+	setConnectorGroupState(group, &state)
+
+	if state.ID.ValueInt64() != 42 || state.Environment.ValueString() != "esx" || state.ProvisioningKey.ValueString() != "key" ||
+		state.KeyExpiresAt.ValueString() != "2025-07-01T12:00:00Z" || state.ConnectorsCount.ValueInt64() != 3 {
+		t.Errorf("unexpected state: %+v", state)
+	}
+}
+
+func testAccCheckConnectorGroupDestroy(s *terraform.State) error {
+	ctx := context.Background()
+	c := testAccClientFactory().GetResConnClient(ctx)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ciscosecureaccess_resource_connector_group" {
+			continue
+		}
+		id := atoi64(rs.Primary.ID)
+		_, httpRes, _ := c.ConnectorGroupsAPI.GetConnectorGroup(ctx, id).Execute()
+		if httpRes == nil || httpRes.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("resource connector group %d still exists after destroy", id)
+		}
+	}
+	return nil
+}
+
+func testAccConnectorGroupConfig(name, location string, keyVersion int) string {
+	return fmt.Sprintf(`
+resource "ciscosecureaccess_resource_connector_group" "test" {
+  name                     = %q
+  location                 = %q
+  provisioning_key_version = %d
+}
+`, name, location, keyVersion)
+}
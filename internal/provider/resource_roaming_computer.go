@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -36,16 +37,17 @@ type roamingComputerResource struct {
 }
 
 type roamingComputerResourceModel struct {
-	OriginId      types.Int64  `tfsdk:"origin_id"`
-	DeviceId      types.String `tfsdk:"device_id"`
-	Name          types.String `tfsdk:"name"`
-	Type          types.String `tfsdk:"type"`
-	Status        types.String `tfsdk:"status"`
-	SwgStatus     types.String `tfsdk:"swg_status"`
-	LastSync      types.String `tfsdk:"last_sync"`
-	Version       types.String `tfsdk:"version"`
-	OsVersion     types.String `tfsdk:"os_version"`
-	OsVersionName types.String `tfsdk:"os_version_name"`
+	OriginId      types.Int64    `tfsdk:"origin_id"`
+	DeviceId      types.String   `tfsdk:"device_id"`
+	Name          types.String   `tfsdk:"name"`
+	Type          types.String   `tfsdk:"type"`
+	Status        types.String   `tfsdk:"status"`
+	SwgStatus     types.String   `tfsdk:"swg_status"`
+	LastSync      types.String   `tfsdk:"last_sync"`
+	Version       types.String   `tfsdk:"version"`
+	OsVersion     types.String   `tfsdk:"os_version"`
+	OsVersionName types.String   `tfsdk:"os_version_name"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *roamingComputerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -68,7 +70,7 @@ func (r *roamingComputerResource) Configure(ctx context.Context, req resource.Co
 	r.client = *factory.GetRoamingClient(ctx)
 }
 
-func (r *roamingComputerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *roamingComputerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "Manages a Cisco Secure Access Roaming Computer resource. Roaming computers are registered externally and must be imported into Terraform before they can be managed.",
 		MarkdownDescription: "Manages a Cisco Secure Access Roaming Computer resource. Roaming computers are registered externally and must be imported into Terraform before they can be managed.",
@@ -134,6 +136,9 @@ func (r *roamingComputerResource) Schema(_ context.Context, _ resource.SchemaReq
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -153,6 +158,14 @@ func (r *roamingComputerResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	deviceId := state.DeviceId.ValueString()
 	tflog.Debug(ctx, "Reading roaming computer", map[string]interface{}{"device_id": deviceId})
 
@@ -191,6 +204,14 @@ func (r *roamingComputerResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	deviceId := plan.DeviceId.ValueString()
 	updateRequest := *roaming.NewUpdateRoamingComputerRequest(plan.Name.ValueString())
 
@@ -222,6 +243,14 @@ func (r *roamingComputerResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	deviceId := state.DeviceId.ValueString()
 	tflog.Info(ctx, "Deleting roaming computer", map[string]interface{}{"device_id": deviceId})
 
@@ -7,11 +7,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"os"
 	"testing"
 	"time"
 
-	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
 	"github.com/CiscoDevNet/go-ciscosecureaccess/roaming"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -125,10 +123,9 @@ func TestSetRoamingComputerState_EmptyDefaults(t *testing.T) {
 const testRoamingComputerResourceName = "ciscosecureaccess_roaming_computer.test_resource"
 
 func TestRoamingComputerResource_importAndUpdate(t *testing.T) {
-	deviceId := os.Getenv("CISCOSECUREACCESS_TEST_ROAMING_DEVICE_ID")
-	if deviceId == "" {
-		t.Skip("CISCOSECUREACCESS_TEST_ROAMING_DEVICE_ID not set, skipping acceptance test")
-	}
+	deviceId := testAccFixture(t, "CISCOSECUREACCESS_TEST_ROAMING_DEVICE_ID", func(f *fakeAPIServer) string {
+		return f.SeedRoamingComputer("tfacc-roaming")
+	})
 	updatedName := "tfacc-roaming-updated"
 
 	rateLimitedTest(t, func() {
@@ -157,10 +154,7 @@ func TestRoamingComputerResource_importAndUpdate(t *testing.T) {
 
 func testAccCheckRoamingComputerDestroy(s *terraform.State) error {
 	ctx := context.Background()
-	factory := &client.SSEClientFactory{
-		KeyId:     os.Getenv("CISCOSECUREACCESS_KEY_ID"),
-		KeySecret: os.Getenv("CISCOSECUREACCESS_KEY_SECRET"),
-	}
+	factory := testAccClientFactory()
 	c := factory.GetRoamingClient(ctx)
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ciscosecureaccess_roaming_computer" {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
 	"github.com/CiscoDevNet/go-ciscosecureaccess/sites"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -41,13 +42,14 @@ type siteResource struct {
 
 // siteResourceModel maps the data schema data.
 type siteResourceModel struct {
-	Id                   types.Int64  `tfsdk:"id"`
-	Name                 types.String `tfsdk:"name"`
-	OriginId             types.Int64  `tfsdk:"origin_id"`
-	IsDefault            types.Bool   `tfsdk:"is_default"`
-	Type                 types.String `tfsdk:"type"`
-	InternalNetworkCount types.Int64  `tfsdk:"internal_network_count"`
-	VaCount              types.Int64  `tfsdk:"va_count"`
+	Id                   types.Int64    `tfsdk:"id"`
+	Name                 types.String   `tfsdk:"name"`
+	OriginId             types.Int64    `tfsdk:"origin_id"`
+	IsDefault            types.Bool     `tfsdk:"is_default"`
+	Type                 types.String   `tfsdk:"type"`
+	InternalNetworkCount types.Int64    `tfsdk:"internal_network_count"`
+	VaCount              types.Int64    `tfsdk:"va_count"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -71,7 +73,7 @@ func (r *siteResource) Configure(ctx context.Context, req resource.ConfigureRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *siteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *siteResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "Manages a Site in the Cisco Secure Access organization.",
 		MarkdownDescription: "Manages a Site in the Cisco Secure Access organization.",
@@ -125,6 +127,9 @@ func (r *siteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -139,6 +144,14 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	createSiteRequest := *sites.NewCreateSiteRequest(plan.Name.ValueString())
 
 	createResp, _, err := r.client.SitesAPI.CreateSite(ctx).CreateSiteRequest(createSiteRequest).Execute()
@@ -170,6 +183,14 @@ func (r *siteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	siteId := state.Id.ValueInt64()
 	tflog.Debug(ctx, "Reading site", map[string]interface{}{"id": siteId})
 
@@ -204,6 +225,14 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	siteId := state.Id.ValueInt64()
 	plan.Id = state.Id
 
@@ -234,6 +263,14 @@ func (r *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	siteId := state.Id.ValueInt64()
 	tflog.Debug(ctx, "Deleting site", map[string]interface{}{"id": siteId})
 
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -38,10 +39,11 @@ type swgDeviceSettingsResource struct {
 }
 
 type swgDeviceSettingsResourceModel struct {
-	OriginIds    types.List   `tfsdk:"origin_ids"`
-	Value        types.String `tfsdk:"value"`
-	SuccessCount types.Int64  `tfsdk:"success_count"`
-	FailCount    types.Int64  `tfsdk:"fail_count"`
+	OriginIds    types.List     `tfsdk:"origin_ids"`
+	Value        types.String   `tfsdk:"value"`
+	SuccessCount types.Int64    `tfsdk:"success_count"`
+	FailCount    types.Int64    `tfsdk:"fail_count"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *swgDeviceSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,7 +66,7 @@ func (r *swgDeviceSettingsResource) Configure(ctx context.Context, req resource.
 	r.client = *factory.GetSwgClient(ctx)
 }
 
-func (r *swgDeviceSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *swgDeviceSettingsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version:             0,
 		Description:         "Manages Cisco Secure Access Secure Web Gateway device settings for a batch of origin IDs.",
@@ -97,6 +99,9 @@ func (r *swgDeviceSettingsResource) Schema(_ context.Context, _ resource.SchemaR
 				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -117,6 +122,14 @@ func (r *swgDeviceSettingsResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	if planRep, err := json.Marshal(plan); err == nil {
 		tflog.Debug(ctx, "Local SWG device settings definition", map[string]interface{}{"definition": string(planRep)})
 	}
@@ -157,6 +170,14 @@ func (r *swgDeviceSettingsResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	originIds := originIdsFromList(ctx, state.OriginIds, resp.Diagnostics.AddError)
 	if resp.Diagnostics.HasError() {
 		return
@@ -214,6 +235,14 @@ func (r *swgDeviceSettingsResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	originIds := originIdsFromList(ctx, plan.OriginIds, resp.Diagnostics.AddError)
 	if resp.Diagnostics.HasError() {
 		return
@@ -243,6 +272,7 @@ func (r *swgDeviceSettingsResource) Update(ctx context.Context, req resource.Upd
 	state.Value = types.StringValue(string(updateResp.GetValue()))
 	state.SuccessCount = types.Int64Value(updateResp.GetSuccessCount())
 	state.FailCount = types.Int64Value(updateResp.GetFailCount())
+	state.Timeouts = plan.Timeouts
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -255,6 +285,14 @@ func (r *swgDeviceSettingsResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	originIds := originIdsFromList(ctx, state.OriginIds, resp.Diagnostics.AddError)
 	if resp.Diagnostics.HasError() {
 		return
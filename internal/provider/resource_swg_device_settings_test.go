@@ -7,11 +7,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"os"
 	"strconv"
 	"testing"
 
-	"github.com/CiscoDevNet/go-ciscosecureaccess/client"
 	"github.com/CiscoDevNet/go-ciscosecureaccess/swg"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -123,10 +121,9 @@ func TestOriginIdsFromList(t *testing.T) {
 const testSWGDeviceSettingsResourceName = "ciscosecureaccess_swg_device_settings.test_resource"
 
 func TestSWGDeviceSettingsResource_basic(t *testing.T) {
-	originIdStr := os.Getenv("CISCOSECUREACCESS_TEST_SWG_ORIGIN_ID")
-	if originIdStr == "" {
-		t.Skip("CISCOSECUREACCESS_TEST_SWG_ORIGIN_ID not set, skipping acceptance test")
-	}
+	originIdStr := testAccFixture(t, "CISCOSECUREACCESS_TEST_SWG_ORIGIN_ID", func(f *fakeAPIServer) string {
+		return strconv.FormatInt(f.SeedRoamingComputerOriginID("tfacc-swg"), 10)
+	})
 	originId, err := strconv.ParseInt(originIdStr, 10, 64)
 	if err != nil {
 		t.Fatalf("CISCOSECUREACCESS_TEST_SWG_ORIGIN_ID must be a valid integer: %v", err)
@@ -163,10 +160,7 @@ func TestSWGDeviceSettingsResource_basic(t *testing.T) {
 
 func testAccCheckSWGDeviceSettingsDestroy(s *terraform.State) error {
 	ctx := context.Background()
-	factory := &client.SSEClientFactory{
-		KeyId:     os.Getenv("CISCOSECUREACCESS_KEY_ID"),
-		KeySecret: os.Getenv("CISCOSECUREACCESS_KEY_SECRET"),
-	}
+	factory := testAccClientFactory()
 	c := factory.GetSwgClient(ctx)
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ciscosecureaccess_swg_device_settings" {
@@ -16,6 +16,10 @@ var (
 	lastTestTime    time.Time
 	testMutex       sync.Mutex
 	minWaitTime     = 5 * time.Second // Minimum wait time between tests
+
+	// testRateLimitDisabled skips the waits when tests run against the
+	// in-process fake API, which has no rate limits.
+	testRateLimitDisabled bool
 )
 
 // rateLimitedTest ensures we don't exceed 2 tests per minute
@@ -23,6 +27,11 @@ func rateLimitedTest(t *testing.T, testFunc func(), minInterval time.Duration) {
 	testMutex.Lock()
 	defer testMutex.Unlock()
 
+	if testRateLimitDisabled {
+		testFunc()
+		return
+	}
+
 	elapsed := time.Since(lastTestTime)
 
 	if elapsed < minInterval {
@@ -0,0 +1,52 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Default operation timeouts, overridable on every resource with a timeouts block
+const (
+	defaultCreateTimeout = 10 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 10 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+
+	// retryMaxDelay caps the backoff between attempts of a retry loop
+	retryMaxDelay = time.Minute
+)
+
+// timeoutsBlock returns the timeouts { create, read, update, delete } block
+// shared by every resource
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// retryUntilDeadline returns retry-go options that retry with exponential
+// backoff from delay until ctx is done. Operations run under the context
+// deadline set from their timeouts block, so the operation timeout rather than
+// an attempt count bounds the loop. When the deadline passes, the error
+// reports the last failed attempt.
+func retryUntilDeadline(ctx context.Context, delay time.Duration) []retry.Option {
+	return []retry.Option{
+		retry.Context(ctx),
+		retry.Attempts(0),
+		retry.Delay(delay),
+		retry.MaxDelay(retryMaxDelay),
+		retry.DelayType(retry.BackOffDelay),
+		retry.WrapContextErrorWithLastError(true),
+	}
+}
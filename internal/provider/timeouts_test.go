@@ -0,0 +1,84 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestRetryUntilDeadline_stopsAtDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	attempts := 0
+	start := time.Now()
+	err := retry.Do(func() error {
+		attempts++
+		return errors.New("connector not registered yet")
+	}, retryUntilDeadline(ctx, 5*time.Millisecond)...)
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("retried for %s, want the loop to end at the 100ms deadline", elapsed)
+	}
+	if attempts < 2 {
+		t.Errorf("attempts = %d, want retries until the deadline", attempts)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want it to wrap the deadline", err)
+	}
+	if err == nil || !strings.Contains(err.Error(), "connector not registered yet") {
+		t.Errorf("error = %v, want it to report the last attempt", err)
+	}
+}
+
+func TestRetryUntilDeadline_unrecoverable(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	attempts := 0
+	err := retry.Do(func() error {
+		attempts++
+		return retry.Unrecoverable(errors.New("forbidden"))
+	}, retryUntilDeadline(ctx, time.Millisecond)...)
+
+	if attempts != 1 || err == nil || err.Error() != "forbidden" {
+		t.Errorf("got %d attempts and error %v, want 1 attempt failing with forbidden", attempts, err)
+	}
+}
+
+// TestResources_timeoutsBlock checks that every resource accepts a timeouts
+// block with all four operations
+func TestResources_timeoutsBlock(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		var metadata fwresource.MetadataResponse
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "ciscosecureaccess"}, &metadata)
+
+		var schemaResp fwresource.SchemaResponse
+		r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+		block, ok := schemaResp.Schema.Blocks["timeouts"]
+		if !ok {
+			t.Errorf("%s has no timeouts block", metadata.TypeName)
+			continue
+		}
+		for _, operation := range []string{"create", "read", "update", "delete"} {
+			if _, ok := block.GetNestedObject().GetAttributes()[operation]; !ok {
+				t.Errorf("%s timeouts block has no %s attribute", metadata.TypeName, operation)
+			}
+		}
+	}
+}
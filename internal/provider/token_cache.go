@@ -0,0 +1,166 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// tokenStores holds one token per set of credentials, so provider aliases
+// configured with the same API key in one Terraform run share a single token
+// instead of each requesting their own.
+var tokenStores = struct {
+	mu     sync.Mutex
+	stores map[string]*tokenStore
+}{stores: map[string]*tokenStore{}}
+
+// tokenCacheKey identifies the token of an API key at an endpoint, scoped to
+// a child organization when childOrgID is set. The secret is part of the key
+// so a rotated secret never reuses a token obtained with the old one; only
+// the hash is ever written to disk.
+func tokenCacheKey(keyID, keySecret, apiEndpoint, childOrgID string) string {
+	sum := sha256.Sum256([]byte(keyID + "\x00" + keySecret + "\x00" + apiEndpoint + "\x00" + childOrgID))
+	return hex.EncodeToString(sum[:])
+}
+
+// sharedTokenSource returns a token source that shares the process-wide
+// token of key and requests new ones from base. Each provider instance passes
+// its own base, so token requests always go through the transport of the
+// instance that makes them. When cacheDir is set, tokens are also persisted
+// there so later Terraform runs reuse them until they expire.
+func sharedTokenSource(key, cacheDir string, base oauth2.TokenSource) *cachingTokenSource {
+	tokenStores.mu.Lock()
+	defer tokenStores.mu.Unlock()
+
+	id := key
+	if cacheDir != "" {
+		id = key + "\x00" + cacheDir
+	}
+	store, ok := tokenStores.stores[id]
+	if !ok {
+		store = &tokenStore{}
+		if cacheDir != "" {
+			store.path = filepath.Join(cacheDir, key+".json")
+		}
+		tokenStores.stores[id] = store
+	}
+	return &cachingTokenSource{store: store, base: base}
+}
+
+// tokenStore holds the current token of one set of credentials. The token is
+// read from and written to path when it is set; a missing or unreadable cache
+// file is not an error.
+type tokenStore struct {
+	mu     sync.Mutex
+	path   string
+	token  *oauth2.Token
+	loaded bool
+}
+
+// cachingTokenSource hands out the token of store until it expires or is
+// invalidated and then fetches a new one from base
+type cachingTokenSource struct {
+	store *tokenStore
+	base  oauth2.TokenSource
+}
+
+// Token implements oauth2.TokenSource
+func (s *cachingTokenSource) Token() (*oauth2.Token, error) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+
+	if !s.store.loaded {
+		s.store.loaded = true
+		s.store.token = s.store.readCache()
+	}
+	if s.store.token.Valid() {
+		return s.store.token, nil
+	}
+
+	token, err := s.base.Token()
+	if err != nil {
+		return nil, err
+	}
+	s.store.token = token
+	s.store.writeCache(token)
+	return token, nil
+}
+
+// invalidate drops the current token, and its cache file, if it is still
+// accessToken, so the next call to Token requests a new one. A token that was
+// already replaced by a concurrent request is kept.
+func (s *cachingTokenSource) invalidate(accessToken string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+
+	if s.store.token == nil || s.store.token.AccessToken != accessToken {
+		return
+	}
+	s.store.token = nil
+	if s.store.path != "" {
+		_ = os.Remove(s.store.path)
+	}
+}
+
+// readCache returns the cached token, or nil when there is no cache file or
+// the token it holds has expired
+func (s *tokenStore) readCache() *oauth2.Token {
+	if s.path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil
+	}
+	var token oauth2.Token
+	if err := json.Unmarshal(data, &token); err != nil || !token.Valid() {
+		return nil
+	}
+	return &token
+}
+
+// writeCache persists token. Failures are ignored: the token is still cached
+// in memory and the next run requests a new one.
+func (s *tokenStore) writeCache(token *oauth2.Token) {
+	if s.path == "" {
+		return
+	}
+	data, err := json.Marshal(&oauth2.Token{AccessToken: token.AccessToken, TokenType: token.TokenType, Expiry: token.Expiry})
+	if err != nil {
+		return
+	}
+	_ = writeFileAtomic(s.path, data)
+}
+
+// writeFileAtomic writes data to a temporary file readable only by the
+// current user and renames it to path, so concurrent Terraform runs never
+// read a partial token
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".token-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
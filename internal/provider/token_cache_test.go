@@ -0,0 +1,150 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// countingTokenSource issues a new token valid for lifetime on every call
+type countingTokenSource struct {
+	calls    int
+	lifetime time.Duration
+}
+
+func (s *countingTokenSource) Token() (*oauth2.Token, error) {
+	s.calls++
+	return &oauth2.Token{
+		AccessToken: "token-" + strconv.Itoa(s.calls),
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(s.lifetime),
+	}, nil
+}
+
+func TestSharedTokenSource_sharedByCredentials(t *testing.T) {
+	base := &countingTokenSource{lifetime: time.Hour}
+	other := &countingTokenSource{lifetime: time.Hour}
+
+	key := tokenCacheKey(t.Name(), "secret", defaultAPIEndpoint, "")
+	first := sharedTokenSource(key, "", base)
+	second := sharedTokenSource(key, "", other)
+	for _, source := range []oauth2.TokenSource{first, second, first} {
+		if _, err := source.Token(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if base.calls != 1 || other.calls != 0 {
+		t.Errorf("requested %d and %d tokens, want 1 and 0", base.calls, other.calls)
+	}
+
+	// Once the shared token is dropped, the source that asks next requests the
+	// new token through its own base
+	token, _ := first.Token()
+	first.invalidate(token.AccessToken)
+	if _, err := second.Token(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if base.calls != 1 || other.calls != 1 {
+		t.Errorf("requested %d and %d tokens, want 1 and 1", base.calls, other.calls)
+	}
+
+	// A child organization or a different secret gets its own token
+	for _, other := range []string{
+		tokenCacheKey(t.Name(), "secret", defaultAPIEndpoint, "8000002"),
+		tokenCacheKey(t.Name(), "rotated", defaultAPIEndpoint, ""),
+	} {
+		if other == key {
+			t.Fatalf("tokenCacheKey collision: %s", other)
+		}
+	}
+}
+
+func TestCachingTokenSource_refreshesExpiredToken(t *testing.T) {
+	base := &countingTokenSource{lifetime: time.Second}
+	source := &cachingTokenSource{store: &tokenStore{}, base: base}
+
+	for range 2 {
+		if _, err := source.Token(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// Tokens within oauth2's expiry margin are treated as expired
+	if base.calls != 2 {
+		t.Errorf("requested %d tokens, want 2", base.calls)
+	}
+}
+
+func TestCachingTokenSource_persistsToDisk(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "tokens")
+	path := filepath.Join(dir, "key.json")
+
+	base := &countingTokenSource{lifetime: time.Hour}
+	token, err := (&cachingTokenSource{store: &tokenStore{path: path}, base: base}).Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("token was not cached: %v", err)
+	}
+	if mode := info.Mode().Perm(); mode != 0o600 {
+		t.Errorf("cache file mode = %o, want 600", mode)
+	}
+
+	// A later run reads the cached token instead of authenticating
+	next := &countingTokenSource{lifetime: time.Hour}
+	cached, err := (&cachingTokenSource{store: &tokenStore{path: path}, base: next}).Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if next.calls != 0 || cached.AccessToken != token.AccessToken {
+		t.Errorf("got token %q after %d requests, want cached token %q", cached.AccessToken, next.calls, token.AccessToken)
+	}
+
+	// An expired or corrupt cache is replaced
+	for _, content := range []string{`{"access_token":"old","expiry":"2000-01-01T00:00:00Z"}`, `not json`} {
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		next := &countingTokenSource{lifetime: time.Hour}
+		if _, err := (&cachingTokenSource{store: &tokenStore{path: path}, base: next}).Token(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if next.calls != 1 {
+			t.Errorf("cache %q: requested %d tokens, want 1", content, next.calls)
+		}
+	}
+}
+
+func TestCachingTokenSource_invalidate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "key.json")
+	base := &countingTokenSource{lifetime: time.Hour}
+	source := &cachingTokenSource{store: &tokenStore{path: path}, base: base}
+
+	token, err := source.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A token that was already replaced is kept
+	source.invalidate("stale")
+	if current, _ := source.Token(); current.AccessToken != token.AccessToken {
+		t.Errorf("got token %q, want %q", current.AccessToken, token.AccessToken)
+	}
+
+	source.invalidate(token.AccessToken)
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("cache file was not removed: %v", err)
+	}
+	if current, _ := source.Token(); current.AccessToken == token.AccessToken || base.calls != 2 {
+		t.Errorf("got token %q after %d requests, want a new token", current.AccessToken, base.calls)
+	}
+}
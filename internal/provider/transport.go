@@ -0,0 +1,144 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// transportConfig holds the network settings applied to every connection the
+// provider makes, token requests included
+type transportConfig struct {
+	// ProxyURL overrides the HTTPS_PROXY and NO_PROXY environment variables
+	ProxyURL *url.URL
+	// CACertPEM holds certificates trusted in addition to the system roots
+	CACertPEM          []byte
+	InsecureSkipVerify bool
+	// ClientCertPEM and ClientKeyPEM authenticate the provider to a proxy
+	// or endpoint that requires mutual TLS
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+}
+
+// newBaseTransport returns a copy of http.DefaultTransport with the proxy and
+// TLS settings of config applied
+func newBaseTransport(config transportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.ProxyURL != nil {
+		transport.Proxy = http.ProxyURL(config.ProxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// Skipping verification is an explicit, warned about, user choice
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if len(config.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(config.CACertPEM) {
+			return nil, errors.New("no PEM encoded certificates found in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(config.ClientCertPEM) > 0 || len(config.ClientKeyPEM) > 0 {
+		cert, err := tls.X509KeyPair(config.ClientCertPEM, config.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// resolveTransportConfig resolves the proxy, CA bundle and client
+// certificate settings from the provider configuration, falling back to
+// environment variables. Certificates may be given inline as PEM or as a
+// file path, but not both.
+func resolveTransportConfig(config ciscosecureaccessProviderModel) (transportConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var result transportConfig
+
+	if proxy := resolveStringSetting(config.ProxyURL, envProxyURL); proxy != "" {
+		u, err := url.Parse(proxy)
+		switch {
+		case err != nil:
+			diags.AddAttributeError(path.Root("proxy_url"), "Invalid Proxy URL", fmt.Sprintf("proxy_url %q is not a valid URL: %s", proxy, err.Error()))
+		case u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "socks5" || u.Host == "":
+			diags.AddAttributeError(path.Root("proxy_url"), "Invalid Proxy URL",
+				fmt.Sprintf("proxy_url %q must be an absolute http, https or socks5 URL, such as http://proxy.example.com:3128", proxy))
+		default:
+			result.ProxyURL = u
+		}
+	}
+
+	var err error
+	result.CACertPEM, err = resolvePEMSetting(config.CACertPEM, config.CACertFile, envCACertFile)
+	if err != nil {
+		diags.AddAttributeError(path.Root("ca_cert_file"), "Invalid CA Certificate File", err.Error())
+	}
+	result.ClientCertPEM, err = resolvePEMSetting(config.ClientCertPEM, config.ClientCertFile, envClientCertFile)
+	if err != nil {
+		diags.AddAttributeError(path.Root("client_cert_file"), "Invalid Client Certificate File", err.Error())
+	}
+	result.ClientKeyPEM, err = resolvePEMSetting(config.ClientKeyPEM, config.ClientKeyFile, envClientKeyFile)
+	if err != nil {
+		diags.AddAttributeError(path.Root("client_key_file"), "Invalid Client Key File", err.Error())
+	}
+	if (len(result.ClientCertPEM) > 0) != (len(result.ClientKeyPEM) > 0) {
+		diags.AddAttributeError(
+			path.Root("client_cert_pem"),
+			"Incomplete Client Certificate",
+			"A client certificate and its private key must be configured together, with client_cert_pem or client_cert_file and client_key_pem or client_key_file.",
+		)
+	}
+
+	if !config.InsecureSkipVerify.IsNull() && !config.InsecureSkipVerify.IsUnknown() {
+		result.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	} else if env := os.Getenv(envInsecureSkipVerify); env != "" {
+		v, err := strconv.ParseBool(env)
+		if err != nil {
+			diags.AddAttributeError(path.Root("insecure_skip_verify"), "Invalid Insecure Skip Verify",
+				fmt.Sprintf("insecure_skip_verify must be true or false, check the %s environment variable.", envInsecureSkipVerify))
+		}
+		result.InsecureSkipVerify = v
+	}
+
+	return result, diags
+}
+
+// resolvePEMSetting returns inline PEM content, or the content of the file
+// named by the file attribute or envVar
+func resolvePEMSetting(pem, file types.String, envVar string) ([]byte, error) {
+	if !pem.IsNull() && !pem.IsUnknown() && pem.ValueString() != "" {
+		return []byte(pem.ValueString()), nil
+	}
+	name := resolveStringSetting(file, envVar)
+	if name == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	return data, nil
+}
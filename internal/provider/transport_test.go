@@ -0,0 +1,200 @@
+// Copyright 2025 Cisco Systems, Inc. and its affiliates
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testClientCertificate returns a self-signed client certificate and key
+func testClientCertificate(t *testing.T) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// testTransportGet sends a GET request to url through a transport built from config
+func testTransportGet(t *testing.T, config transportConfig, url string) error {
+	t.Helper()
+	transport, err := newBaseTransport(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err := (&http.Client{Transport: transport}).Get(url)
+	if err == nil {
+		resp.Body.Close()
+	}
+	return err
+}
+
+func TestBaseTransport_caCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	serverCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	if err := testTransportGet(t, transportConfig{}, server.URL); err == nil {
+		t.Error("expected the untrusted server certificate to be rejected")
+	}
+	if err := testTransportGet(t, transportConfig{CACertPEM: serverCA}, server.URL); err != nil {
+		t.Errorf("request with the server CA trusted failed: %v", err)
+	}
+	if err := testTransportGet(t, transportConfig{InsecureSkipVerify: true}, server.URL); err != nil {
+		t.Errorf("request without verification failed: %v", err)
+	}
+
+	if _, err := newBaseTransport(transportConfig{CACertPEM: []byte("not a certificate")}); err == nil {
+		t.Error("expected an error for a CA bundle without certificates")
+	}
+}
+
+func TestBaseTransport_clientCertificate(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	if err := testTransportGet(t, transportConfig{InsecureSkipVerify: true}, server.URL); err == nil {
+		t.Error("expected the server to require a client certificate")
+	}
+	certPEM, keyPEM := testClientCertificate(t)
+	config := transportConfig{InsecureSkipVerify: true, ClientCertPEM: certPEM, ClientKeyPEM: keyPEM}
+	if err := testTransportGet(t, config, server.URL); err != nil {
+		t.Errorf("request with a client certificate failed: %v", err)
+	}
+
+	if _, err := newBaseTransport(transportConfig{ClientCertPEM: certPEM}); err == nil {
+		t.Error("expected an error for a client certificate without a key")
+	}
+}
+
+func TestBaseTransport_proxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+	proxyURL, _ := url.Parse(proxy.URL)
+
+	if err := testTransportGet(t, transportConfig{ProxyURL: proxyURL}, "http://api.example.com/policies/v2/rules"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if proxied != "http://api.example.com/policies/v2/rules" {
+		t.Errorf("proxy received %q, want the API request", proxied)
+	}
+}
+
+func TestResolveTransportConfig(t *testing.T) {
+	nullModel := func() ciscosecureaccessProviderModel {
+		return ciscosecureaccessProviderModel{
+			ProxyURL:           types.StringNull(),
+			CACertPEM:          types.StringNull(),
+			CACertFile:         types.StringNull(),
+			InsecureSkipVerify: types.BoolNull(),
+			ClientCertPEM:      types.StringNull(),
+			ClientCertFile:     types.StringNull(),
+			ClientKeyPEM:       types.StringNull(),
+			ClientKeyFile:      types.StringNull(),
+		}
+	}
+	clearEnv := func(t *testing.T) {
+		for _, envVar := range []string{envProxyURL, envCACertFile, envInsecureSkipVerify, envClientCertFile, envClientKeyFile} {
+			t.Setenv(envVar, "")
+		}
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		clearEnv(t)
+		config, diags := resolveTransportConfig(nullModel())
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if config.ProxyURL != nil || config.CACertPEM != nil || config.InsecureSkipVerify || config.ClientCertPEM != nil {
+			t.Errorf("config = %+v, want no overrides", config)
+		}
+	})
+
+	t.Run("environment", func(t *testing.T) {
+		clearEnv(t)
+		certPEM, keyPEM := testClientCertificate(t)
+		dir := t.TempDir()
+		certFile, keyFile := filepath.Join(dir, "client.pem"), filepath.Join(dir, "client-key.pem")
+		if err := os.WriteFile(certFile, certPEM, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(keyFile, keyPEM, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv(envProxyURL, "http://proxy.example.com:3128")
+		t.Setenv(envCACertFile, certFile)
+		t.Setenv(envInsecureSkipVerify, "true")
+		t.Setenv(envClientCertFile, certFile)
+		t.Setenv(envClientKeyFile, keyFile)
+
+		config, diags := resolveTransportConfig(nullModel())
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if config.ProxyURL.Host != "proxy.example.com:3128" || string(config.CACertPEM) != string(certPEM) ||
+			!config.InsecureSkipVerify || string(config.ClientKeyPEM) != string(keyPEM) {
+			t.Errorf("config = %+v, want the environment settings", config)
+		}
+
+		// Inline configuration takes precedence over the environment
+		model := nullModel()
+		model.CACertPEM = types.StringValue("inline")
+		model.InsecureSkipVerify = types.BoolValue(false)
+		config, _ = resolveTransportConfig(model)
+		if string(config.CACertPEM) != "inline" || config.InsecureSkipVerify {
+			t.Errorf("config = %+v, want the provider configuration to override the environment", config)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		clearEnv(t)
+		model := nullModel()
+		model.ProxyURL = types.StringValue("proxy.example.com:3128")
+		model.CACertFile = types.StringValue(filepath.Join(t.TempDir(), "missing.pem"))
+		model.ClientCertPEM = types.StringValue("cert without key")
+		_, diags := resolveTransportConfig(model)
+		if diags.ErrorsCount() != 3 {
+			t.Errorf("got %d errors, want 3: %v", diags.ErrorsCount(), diags)
+		}
+	})
+}